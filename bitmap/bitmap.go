@@ -11,6 +11,10 @@
 //   - Check if a bit is on.
 //   - Get the binary representation or the total numeric value of the map.
 //   - Reset the map to zero.
+//   - Clear the bits set in another bitmap (AND NOT).
+//   - Clone a bitmap into an independent copy.
+//   - Shift all bits left or right into a new bitmap.
+//   - Set a bit from a boolean value.
 //
 // Attempts to access invalid positions (outside the range 0-31) return an error.
 package bitmap
@@ -89,6 +93,22 @@ func (bm *BitMap) Toggle(pos uint8) error {
 	return nil
 }
 
+// SetTo() sets the bit at the specified position to 1 if value is true, or to 0
+// otherwise.
+//
+// Parameters:
+//   - pos: The position of the bit to set (must be between 0 and 31).
+//   - value: The state to set the bit to.
+//
+// Returns:
+//   - An error if the position is out of range.
+func (bm *BitMap) SetTo(pos uint8, value bool) error {
+	if value {
+		return bm.On(pos)
+	}
+	return bm.Off(pos)
+}
+
 // Reset() clears all bits in the bitmap, setting them to 0.
 func (bm *BitMap) Reset() {
 	bm.bits = 0
@@ -128,6 +148,53 @@ func (bm *BitMap) String() string {
 	return fmt.Sprintf("%032b", bm.bits)
 }
 
+// AndNot() returns a new bitmap with the bits that are set in the current bitmap
+// but not in the other one. Neither operand is modified.
+//
+// Parameters:
+//   - other: The bitmap whose set bits are cleared from the result.
+//
+// Returns:
+//   - A pointer to a new BitMap holding the result of the operation.
+func (bm *BitMap) AndNot(other *BitMap) *BitMap {
+	return &BitMap{bits: bm.bits &^ other.bits}
+}
+
+// Clone() returns a new bitmap with the same bits as the current one. Changes to
+// either bitmap do not affect the other.
+//
+// Returns:
+//   - A pointer to a new BitMap holding a copy of the bits.
+func (bm *BitMap) Clone() *BitMap {
+	return &BitMap{bits: bm.bits}
+}
+
+// ShiftLeft() returns a new bitmap with the bits shifted n positions towards the
+// most significant bit. Bits shifted past position 31 are dropped, so shifting by
+// 32 or more yields an all-zero bitmap. The current bitmap is not modified.
+//
+// Parameters:
+//   - n: The number of positions to shift.
+//
+// Returns:
+//   - A pointer to a new BitMap holding the shifted bits.
+func (bm *BitMap) ShiftLeft(n uint8) *BitMap {
+	return &BitMap{bits: bm.bits << n}
+}
+
+// ShiftRight() returns a new bitmap with the bits shifted n positions towards the
+// least significant bit. Bits shifted past position 0 are dropped, so shifting by
+// 32 or more yields an all-zero bitmap. The current bitmap is not modified.
+//
+// Parameters:
+//   - n: The number of positions to shift.
+//
+// Returns:
+//   - A pointer to a new BitMap holding the shifted bits.
+func (bm *BitMap) ShiftRight(n uint8) *BitMap {
+	return &BitMap{bits: bm.bits >> n}
+}
+
 // isOutOfRange() checks if a given position is outside the valid range of the
 // bitmap.
 //
@@ -162,3 +162,87 @@ func TestBitMapStringRepresentation(t *testing.T) {
 	expected := "10000000000000000000000000000001"
 	assert.Equal(t, expected, m.String())
 }
+
+// TestBitMapAndNot() verifies that AndNot() clears from the receiver the bits set
+// in the other bitmap, leaving both operands unchanged.
+func TestBitMapAndNot(t *testing.T) {
+	a := NewBitMap()
+	b := NewBitMap()
+	for _, pos := range []uint8{0, 1, 2, 3, 31} {
+		a.On(pos)
+	}
+	for _, pos := range []uint8{1, 3, 4} {
+		b.On(pos)
+	}
+	result := a.AndNot(b)
+	assert.Equal(t, uint32(0b10000000000000000000000000000101), result.GetMap())
+	assert.Equal(t, uint32(0b10000000000000000000000000001111), a.GetMap())
+	assert.Equal(t, uint32(0b11010), b.GetMap())
+	assert.Equal(t, uint32(0), a.AndNot(a).GetMap())
+}
+
+// TestBitMapClone() verifies that mutating a cloned bitmap does not affect the
+// original.
+func TestBitMapClone(t *testing.T) {
+	m := NewBitMap()
+	m.On(3)
+	m.On(7)
+	clone := m.Clone()
+	assert.Equal(t, m.GetMap(), clone.GetMap())
+	clone.Off(3)
+	clone.On(10)
+	isOn, _ := m.IsOn(3)
+	assert.True(t, isOn)
+	isOn, _ = m.IsOn(10)
+	assert.False(t, isOn)
+	assert.Equal(t, uint32(0b10001000), m.GetMap())
+}
+
+// TestBitMapShiftLeft() verifies that ShiftLeft() moves the bits towards position
+// 31, drops the bits that overflow, and leaves the operand unchanged.
+func TestBitMapShiftLeft(t *testing.T) {
+	m := NewBitMap()
+	m.On(0)
+	m.On(3)
+	m.On(30)
+	assert.Equal(t, uint32(1<<31|1<<4|1<<1), m.ShiftLeft(1).GetMap())
+	assert.Equal(t, uint32(1<<5|1<<2), m.ShiftLeft(2).GetMap())
+	assert.Equal(t, m.GetMap(), m.ShiftLeft(0).GetMap())
+	assert.Equal(t, uint32(1<<31), m.ShiftLeft(31).GetMap())
+	assert.Equal(t, uint32(0), m.ShiftLeft(32).GetMap())
+	assert.Equal(t, uint32(0), m.ShiftLeft(255).GetMap())
+	assert.Equal(t, uint32(1<<30|1<<3|1), m.GetMap())
+}
+
+// TestBitMapShiftRight() verifies that ShiftRight() moves the bits towards
+// position 0, drops the bits that underflow, and leaves the operand unchanged.
+func TestBitMapShiftRight(t *testing.T) {
+	m := NewBitMap()
+	m.On(0)
+	m.On(3)
+	m.On(31)
+	assert.Equal(t, uint32(1<<30|1<<2), m.ShiftRight(1).GetMap())
+	assert.Equal(t, uint32(1<<28|1), m.ShiftRight(3).GetMap())
+	assert.Equal(t, uint32(1), m.ShiftRight(31).GetMap())
+	assert.Equal(t, uint32(0), m.ShiftRight(32).GetMap())
+	assert.Equal(t, uint32(0), m.ShiftRight(255).GetMap())
+	assert.Equal(t, uint32(1<<31|1<<3|1), m.GetMap())
+}
+
+// TestBitMapSetTo() verifies that SetTo() turns a bit on for true and off for
+// false, and returns an error for a position out of range.
+func TestBitMapSetTo(t *testing.T) {
+	m := NewBitMap()
+	assert.NoError(t, m.SetTo(5, true))
+	isOn, _ := m.IsOn(5)
+	assert.True(t, isOn)
+	assert.NoError(t, m.SetTo(5, true))
+	isOn, _ = m.IsOn(5)
+	assert.True(t, isOn)
+	assert.NoError(t, m.SetTo(5, false))
+	isOn, _ = m.IsOn(5)
+	assert.False(t, isOn)
+	assert.Equal(t, uint32(0), m.GetMap())
+	assert.EqualError(t, m.SetTo(32, true), "invalid position")
+	assert.ErrorIs(t, m.SetTo(40, false), ErrInvalidPosition)
+}
@@ -0,0 +1,144 @@
+// Package circularqueue provides a generic fixed-capacity circular queue
+// implemented using Go generics. It stores elements of any type (T) in a
+// first-in, first-out (FIFO) manner over a fixed slice, with every operation
+// running in O(1) time.
+//
+// Unlike the growable queue package, a circular queue never reallocates and
+// rejects new elements once it is full, which makes it suitable for bounded
+// buffers, producer-consumer pipelines, and systems with strict memory limits.
+//
+// Included features:
+//   - Create a circular queue with a fixed capacity.
+//   - Enqueue elements to the back of the queue.
+//   - Dequeue elements from the front of the queue.
+//   - Peek at the front element without removing it.
+//   - Check if the queue is empty or full.
+//   - Get the number of elements in the queue.
+//   - Get a string representation of the queue contents.
+//
+// Attempting to enqueue into a full queue, or to dequeue or peek from an empty
+// queue, will return an error.
+package circularqueue
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrFullQueue is returned when an element is enqueued into a full queue.
+	ErrFullQueue = errors.New("queue full")
+	// ErrEmptyQueue is returned when an element is dequeued or inspected from an
+	// empty queue.
+	ErrEmptyQueue = errors.New("empty queue")
+)
+
+// CircularQueue[T any] represents a generic queue with a fixed capacity. Elements
+// are stored in a slice of fixed length, where head points to the front element
+// and tail to the position where the next element will be enqueued.
+type CircularQueue[T any] struct {
+	data []T
+	head int
+	tail int
+	size int
+}
+
+// NewCircularQueue[T any]() creates and returns a new empty circular queue with
+// the given capacity. A capacity less than 1 creates a queue that is always full.
+//
+// Parameters:
+//   - capacity: The maximum number of elements the queue can hold.
+//
+// Returns:
+//   - A pointer to a new empty circular queue.
+func NewCircularQueue[T any](capacity int) *CircularQueue[T] {
+	return &CircularQueue[T]{data: make([]T, max(capacity, 0))}
+}
+
+// Enqueue() adds an element to the back of the queue.
+//
+// Parameters:
+//   - data: The element to be added to the queue.
+//
+// Returns:
+//   - An error if the queue is full.
+func (q *CircularQueue[T]) Enqueue(data T) error {
+	if q.IsFull() {
+		return ErrFullQueue
+	}
+	q.data[q.tail] = data
+	q.tail = (q.tail + 1) % len(q.data)
+	q.size++
+	return nil
+}
+
+// Dequeue() removes and returns the element at the front of the queue. If the
+// queue is empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The element of type T at the front of the queue.
+//   - An error if the queue is empty.
+func (q *CircularQueue[T]) Dequeue() (T, error) {
+	var zero T
+	if q.IsEmpty() {
+		return zero, ErrEmptyQueue
+	}
+	head := q.data[q.head]
+	q.data[q.head] = zero
+	q.head = (q.head + 1) % len(q.data)
+	q.size--
+	return head, nil
+}
+
+// Front() returns the element at the front of the queue without removing it. If
+// the queue is empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The element of type T at the front of the queue.
+//   - An error if the queue is empty.
+func (q *CircularQueue[T]) Front() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyQueue
+	}
+	return q.data[q.head], nil
+}
+
+// Size() returns the number of elements currently in the queue.
+//
+// Returns:
+//   - The number of elements in the queue.
+func (q *CircularQueue[T]) Size() int {
+	return q.size
+}
+
+// IsEmpty() checks if the queue is empty.
+//
+// Returns:
+//   - true if the queue is empty.
+//   - false if the queue contains at least one element.
+func (q *CircularQueue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// IsFull() checks if the queue has reached its capacity.
+//
+// Returns:
+//   - true if the queue is full.
+//   - false if the queue can hold more elements.
+func (q *CircularQueue[T]) IsFull() bool {
+	return q.size == len(q.data)
+}
+
+// String() returns a string representation of the queue from front to back, which
+// is useful for debugging purposes.
+//
+// Returns:
+//   - A string representing the current elements in the queue.
+func (q *CircularQueue[T]) String() string {
+	values := make([]T, 0, q.size)
+	for i := range q.size {
+		values = append(values, q.data[(q.head+i)%len(q.data)])
+	}
+	return fmt.Sprintf("CircularQueue: %v", values)
+}
@@ -0,0 +1,101 @@
+// Package circularqueue provides a generic fixed-capacity circular queue
+// implemented using Go generics. It stores elements of any type (T) in a
+// first-in, first-out (FIFO) manner over a fixed slice, with every operation
+// running in O(1) time.
+//
+// Unlike the growable queue package, a circular queue never reallocates and
+// rejects new elements once it is full, which makes it suitable for bounded
+// buffers, producer-consumer pipelines, and systems with strict memory limits.
+//
+// Included features:
+//   - Create a circular queue with a fixed capacity.
+//   - Enqueue elements to the back of the queue.
+//   - Dequeue elements from the front of the queue.
+//   - Peek at the front element without removing it.
+//   - Check if the queue is empty or full.
+//   - Get the number of elements in the queue.
+//   - Get a string representation of the queue contents.
+//
+// Attempting to enqueue into a full queue, or to dequeue or peek from an empty
+// queue, will return an error.
+package circularqueue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewCircularQueue() verifies that a newly created circular queue is empty
+// and not full.
+func TestNewCircularQueue(t *testing.T) {
+	q := NewCircularQueue[int](3)
+	assert.NotNil(t, q)
+	assert.True(t, q.IsEmpty())
+	assert.False(t, q.IsFull())
+	assert.Equal(t, 0, q.Size())
+}
+
+// TestCircularQueueEnqueueDequeue() verifies that elements are dequeued in FIFO
+// order.
+func TestCircularQueueEnqueueDequeue(t *testing.T) {
+	q := NewCircularQueue[int](3)
+	assert.NoError(t, q.Enqueue(1))
+	assert.NoError(t, q.Enqueue(2))
+	front, err := q.Front()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, front)
+	v, err := q.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	v, err = q.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.True(t, q.IsEmpty())
+}
+
+// TestCircularQueueFull() ensures that Enqueue() returns an error once the queue
+// reaches its capacity, without overwriting any element.
+func TestCircularQueueFull(t *testing.T) {
+	q := NewCircularQueue[string](2)
+	assert.NoError(t, q.Enqueue("a"))
+	assert.NoError(t, q.Enqueue("b"))
+	assert.True(t, q.IsFull())
+	err := q.Enqueue("c")
+	assert.EqualError(t, err, "queue full")
+	assert.ErrorIs(t, err, ErrFullQueue)
+	assert.Equal(t, "CircularQueue: [a b]", q.String())
+}
+
+// TestCircularQueueWrapAround() verifies that the queue keeps FIFO order while
+// wrapping around its internal slice multiple times.
+func TestCircularQueueWrapAround(t *testing.T) {
+	q := NewCircularQueue[int](3)
+	next := 0
+	for i := range 10 {
+		assert.NoError(t, q.Enqueue(2*i))
+		assert.NoError(t, q.Enqueue(2*i+1))
+		for range 2 {
+			v, err := q.Dequeue()
+			assert.NoError(t, err)
+			assert.Equal(t, next, v)
+			next++
+		}
+	}
+	assert.True(t, q.IsEmpty())
+	for i := range 3 {
+		assert.NoError(t, q.Enqueue(i))
+	}
+	assert.Equal(t, "CircularQueue: [0 1 2]", q.String())
+}
+
+// TestCircularQueueEmptyOperations() ensures that Dequeue() and Front() return an
+// error on an empty queue.
+func TestCircularQueueEmptyOperations(t *testing.T) {
+	q := NewCircularQueue[int](2)
+	_, err := q.Dequeue()
+	assert.EqualError(t, err, "empty queue")
+	_, err = q.Front()
+	assert.ErrorIs(t, err, ErrEmptyQueue)
+	assert.Equal(t, "CircularQueue: []", q.String())
+}
@@ -0,0 +1,223 @@
+// Package deque provides a generic double-ended queue implemented using Go
+// generics. It allows storing elements of any type (T) and adding or removing
+// them at both the front and the back in amortized constant time.
+//
+// This package is useful for algorithms that need to work on both ends of a
+// sequence, such as sliding window computations, work-stealing schedulers, and
+// palindrome checks.
+//
+// Included features:
+//   - Push elements to the front or the back of the deque.
+//   - Pop elements from the front or the back of the deque.
+//   - Peek at the front or back element without removing it.
+//   - Check if the deque is empty.
+//   - Get the number of elements in the deque.
+//   - Clear all elements from the deque.
+//   - Get a string representation of the deque contents.
+//   - Compute sliding window maximums with a monotonic deque.
+//
+// Attempting to pop or peek from an empty deque will return an error.
+package deque
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmptyDeque is returned when an element is popped or inspected from an
+	// empty deque.
+	ErrEmptyDeque = errors.New("empty deque")
+	// ErrInvalidWindowSize is returned when a sliding window size is less than 1
+	// or greater than the number of elements.
+	ErrInvalidWindowSize = errors.New("invalid window size")
+)
+
+// Deque[T any] represents a generic double-ended queue. Elements are stored in a
+// circular slice that doubles its length when full, with head pointing to the
+// front element.
+type Deque[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+// NewDeque[T any]() creates and returns a new empty deque. The type of elements
+// stored in the deque is generic (T).
+//
+// Returns:
+//   - A pointer to a new empty deque.
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// PushFront() adds an element to the front of the deque.
+//
+// Parameters:
+//   - data: The element to be added to the front of the deque.
+func (d *Deque[T]) PushFront(data T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.data)) % len(d.data)
+	d.data[d.head] = data
+	d.size++
+}
+
+// PushBack() adds an element to the back of the deque.
+//
+// Parameters:
+//   - data: The element to be added to the back of the deque.
+func (d *Deque[T]) PushBack(data T) {
+	d.grow()
+	d.data[(d.head+d.size)%len(d.data)] = data
+	d.size++
+}
+
+// PopFront() removes and returns the element at the front of the deque. If the
+// deque is empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The element of type T at the front of the deque.
+//   - An error if the deque is empty.
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if d.IsEmpty() {
+		return zero, ErrEmptyDeque
+	}
+	value := d.data[d.head]
+	d.data[d.head] = zero
+	d.head = (d.head + 1) % len(d.data)
+	d.size--
+	return value, nil
+}
+
+// PopBack() removes and returns the element at the back of the deque. If the
+// deque is empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The element of type T at the back of the deque.
+//   - An error if the deque is empty.
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if d.IsEmpty() {
+		return zero, ErrEmptyDeque
+	}
+	index := (d.head + d.size - 1) % len(d.data)
+	value := d.data[index]
+	d.data[index] = zero
+	d.size--
+	return value, nil
+}
+
+// Front() returns the element at the front of the deque without removing it. If
+// the deque is empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The element of type T at the front of the deque.
+//   - An error if the deque is empty.
+func (d *Deque[T]) Front() (T, error) {
+	if d.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyDeque
+	}
+	return d.data[d.head], nil
+}
+
+// Back() returns the element at the back of the deque without removing it. If the
+// deque is empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The element of type T at the back of the deque.
+//   - An error if the deque is empty.
+func (d *Deque[T]) Back() (T, error) {
+	if d.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyDeque
+	}
+	return d.data[(d.head+d.size-1)%len(d.data)], nil
+}
+
+// IsEmpty() checks if the deque contains no elements.
+//
+// Returns:
+//   - true if the deque is empty.
+//   - false if the deque contains elements.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Size() returns the number of elements currently in the deque.
+//
+// Returns:
+//   - The number of elements in the deque.
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+// Clear() removes all elements from the deque, leaving it empty.
+func (d *Deque[T]) Clear() {
+	d.data = nil
+	d.head = 0
+	d.size = 0
+}
+
+// String() returns a string representation of the deque from front to back, which
+// is useful for debugging purposes.
+//
+// Returns:
+//   - A string representing the current elements in the deque.
+func (d *Deque[T]) String() string {
+	values := make([]T, 0, d.size)
+	for i := range d.size {
+		values = append(values, d.data[(d.head+i)%len(d.data)])
+	}
+	return fmt.Sprintf("Deque: %v", values)
+}
+
+// grow() doubles the length of the internal slice when it is full, copying the
+// elements so that the front one ends up at index 0.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.data) {
+		return
+	}
+	data := make([]T, max(1, 2*len(d.data)))
+	for i := range d.size {
+		data[i] = d.data[(d.head+i)%len(d.data)]
+	}
+	d.data = data
+	d.head = 0
+}
+
+// SlidingWindowMax[T any]() returns the maximum element of every contiguous window
+// of size k in nums, in O(n) time. It keeps a deque of indices whose elements
+// decrease from front to back, so the front always holds the index of the maximum
+// of the current window.
+//
+// Parameters:
+//   - nums: The elements to scan.
+//   - k: The size of the window.
+//   - less: A function that reports whether a is less than b.
+//
+// Returns:
+//   - A slice with len(nums)-k+1 maximums, one per window.
+//   - An error if k is less than 1 or greater than len(nums).
+func SlidingWindowMax[T any](nums []T, k int, less func(a, b T) bool) ([]T, error) {
+	if k < 1 || k > len(nums) {
+		return nil, ErrInvalidWindowSize
+	}
+	maximums := make([]T, 0, len(nums)-k+1)
+	indices := NewDeque[int]()
+	for i, value := range nums {
+		if front, err := indices.Front(); err == nil && front <= i-k {
+			indices.PopFront()
+		}
+		for back, err := indices.Back(); err == nil && !less(value, nums[back]); back, err = indices.Back() {
+			indices.PopBack()
+		}
+		indices.PushBack(i)
+		if i >= k-1 {
+			front, _ := indices.Front()
+			maximums = append(maximums, nums[front])
+		}
+	}
+	return maximums, nil
+}
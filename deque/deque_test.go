@@ -0,0 +1,164 @@
+// Package deque provides a generic double-ended queue implemented using Go
+// generics. It allows storing elements of any type (T) and adding or removing
+// them at both the front and the back in amortized constant time.
+//
+// This package is useful for algorithms that need to work on both ends of a
+// sequence, such as sliding window computations, work-stealing schedulers, and
+// palindrome checks.
+//
+// Included features:
+//   - Push elements to the front or the back of the deque.
+//   - Pop elements from the front or the back of the deque.
+//   - Peek at the front or back element without removing it.
+//   - Check if the deque is empty.
+//   - Get the number of elements in the deque.
+//   - Clear all elements from the deque.
+//   - Get a string representation of the deque contents.
+//   - Compute sliding window maximums with a monotonic deque.
+//
+// Attempting to pop or peek from an empty deque will return an error.
+package deque
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewDeque() verifies that a newly created deque is not nil and is empty.
+func TestNewDeque(t *testing.T) {
+	d := NewDeque[int]()
+	assert.NotNil(t, d)
+	assert.True(t, d.IsEmpty())
+	assert.Equal(t, 0, d.Size())
+}
+
+// TestDequePushAndPop() verifies that elements can be pushed and popped at both
+// ends in the expected order.
+func TestDequePushAndPop(t *testing.T) {
+	d := NewDeque[int]()
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	assert.Equal(t, "Deque: [1 2 3]", d.String())
+	front, err := d.Front()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, front)
+	back, err := d.Back()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, back)
+	v, err := d.PopFront()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	v, err = d.PopBack()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+	v, err = d.PopBack()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.True(t, d.IsEmpty())
+}
+
+// TestDequeEmpty() ensures that popping or peeking from an empty deque returns
+// ErrEmptyDeque.
+func TestDequeEmpty(t *testing.T) {
+	d := NewDeque[string]()
+	_, err := d.PopFront()
+	assert.ErrorIs(t, err, ErrEmptyDeque)
+	_, err = d.PopBack()
+	assert.ErrorIs(t, err, ErrEmptyDeque)
+	_, err = d.Front()
+	assert.ErrorIs(t, err, ErrEmptyDeque)
+	_, err = d.Back()
+	assert.EqualError(t, err, "empty deque")
+}
+
+// TestDequeWrapAroundAndGrow() verifies that a mixed sequence of operations at
+// both ends, which wraps around and grows the internal slice, matches a slice
+// model.
+func TestDequeWrapAroundAndGrow(t *testing.T) {
+	d := NewDeque[int]()
+	var model []int
+	for i := range 200 {
+		switch i % 5 {
+		case 0, 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case 2:
+			d.PushFront(i)
+			model = append([]int{i}, model...)
+		case 3:
+			v, err := d.PopFront()
+			assert.NoError(t, err)
+			assert.Equal(t, model[0], v)
+			model = model[1:]
+		case 4:
+			v, err := d.PopBack()
+			assert.NoError(t, err)
+			assert.Equal(t, model[len(model)-1], v)
+			model = model[:len(model)-1]
+		}
+		assert.Equal(t, len(model), d.Size())
+	}
+	for _, expected := range model {
+		v, err := d.PopFront()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+	}
+	assert.True(t, d.IsEmpty())
+}
+
+// TestDequeClear() verifies that Clear() removes every element and that the deque
+// can be reused afterwards.
+func TestDequeClear(t *testing.T) {
+	d := NewDeque[int]()
+	d.PushBack(1)
+	d.PushFront(0)
+	d.Clear()
+	assert.True(t, d.IsEmpty())
+	assert.Equal(t, "Deque: []", d.String())
+	d.PushFront(5)
+	v, err := d.Back()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, v)
+}
+
+// TestSlidingWindowMax() verifies that SlidingWindowMax() matches a brute-force
+// computation for every valid window size.
+func TestSlidingWindowMax(t *testing.T) {
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7, 7, 2, 0, 4}
+	less := func(a, b int) bool { return a < b }
+	for k := 1; k <= len(nums); k++ {
+		maximums, err := SlidingWindowMax(nums, k, less)
+		assert.NoError(t, err)
+		assert.Equal(t, bruteForceWindowMax(nums, k), maximums, "window size %d", k)
+	}
+	maximums, err := SlidingWindowMax(nums, 3, less)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 3, 5, 5, 6, 7, 7, 7, 7, 4}, maximums)
+}
+
+// TestSlidingWindowMaxInvalidSize() ensures that SlidingWindowMax() returns
+// ErrInvalidWindowSize when the window size is not positive or exceeds the input
+// length.
+func TestSlidingWindowMaxInvalidSize(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	_, err := SlidingWindowMax([]int{1, 2}, 0, less)
+	assert.ErrorIs(t, err, ErrInvalidWindowSize)
+	_, err = SlidingWindowMax([]int{1, 2}, 3, less)
+	assert.EqualError(t, err, "invalid window size")
+}
+
+// bruteForceWindowMax() is a helper function that computes the maximum of every
+// window of size k by scanning each window.
+func bruteForceWindowMax(nums []int, k int) []int {
+	var maximums []int
+	for i := 0; i+k <= len(nums); i++ {
+		maximum := nums[i]
+		for _, value := range nums[i : i+k] {
+			maximum = max(maximum, value)
+		}
+		maximums = append(maximums, maximum)
+	}
+	return maximums
+}
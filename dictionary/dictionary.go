@@ -16,6 +16,21 @@
 //   - Retrieve all keys or values as slices.
 //   - Clear all key-value pairs in the dictionary.
 //   - Get a string representation of the dictionary contents.
+//   - Insert all the entries of a Go map at once.
+//   - Check whether a value is present in the dictionary.
+//   - Invert a dictionary by swapping its keys and values.
+//   - Get the keys as a set for use with set operations.
+//   - Remove every entry matching a predicate.
+//   - Retrieve or store several entries in a single call.
+//   - Compute, update or delete the value of a key in a single call.
+//   - Retrieve the distinct values without duplicates.
+//   - Retrieve the keys or entries sorted by a comparator.
+//   - Check whether the dictionary is empty.
+//   - Update a stored value in place through a pointer.
+//   - Copy all entries into another dictionary.
+//   - Get a string representation with the keys in a stable order.
+//   - Retrieve the keys sorted by their values.
+//   - Convert entries into generic pairs from the tuple package.
 //
 // Most methods return an error if the dictionary receiver is nil.
 package dictionary
@@ -23,14 +38,38 @@ package dictionary
 import (
 	"errors"
 	"fmt"
+	"sort"
+
+	"github.com/trigologiaa/go/set"
+	"github.com/trigologiaa/go/tuple"
 )
 
+// ErrKeyNotFound is returned when a key that does not exist in the dictionary is
+// looked up.
+var ErrKeyNotFound = errors.New("non-existent key")
+
 // Dictionary[K comparable, V any] represents a generic dictionary structure that
 // stores key-value pairs where keys are comparable and values can be any type.
 type Dictionary[K comparable, V any] struct {
 	dict map[K]V
 }
 
+// Entry[K comparable, V any] represents a single key-value pair stored in a
+// dictionary.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Pair() converts the entry into a tuple.Pair, where First holds the key and
+// Second holds the value.
+//
+// Returns:
+//   - A pair with the key and the value of the entry.
+func (e Entry[K, V]) Pair() tuple.Pair[K, V] {
+	return tuple.MakePair(e.Key, e.Value)
+}
+
 // NewDictionary[K comparable, V any]() creates and returns a new empty dictionary.
 //
 // Returns:
@@ -78,7 +117,7 @@ func (d *Dictionary[K, V]) Contains(key K) bool {
 func (d *Dictionary[K, V]) Get(key K) (V, error) {
 	value, exists := d.dict[key]
 	if !exists {
-		return value, errors.New("non-existent key")
+		return value, ErrKeyNotFound
 	}
 	return value, nil
 }
@@ -107,6 +146,18 @@ func (d *Dictionary[K, V]) Size() int {
 	return len(d.dict)
 }
 
+// IsEmpty() checks whether the dictionary contains no entries.
+//
+// Returns:
+//   - true if the dictionary is empty or nil.
+//   - false otherwise.
+func (d *Dictionary[K, V]) IsEmpty() bool {
+	if d == nil {
+		return true
+	}
+	return d.Size() == 0
+}
+
 // Keys() returns a slice containing all keys currently stored in the dictionary.
 //
 // Returns:
@@ -132,6 +183,67 @@ func (d *Dictionary[K, V]) Values() []V {
 	return values
 }
 
+// entries() returns a slice containing all key-value pairs currently stored in
+// the dictionary, in no particular order, for the methods that need to sort them.
+//
+// Returns:
+//   - A slice of entries.
+func (d *Dictionary[K, V]) entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, d.Size())
+	for key, value := range d.dict {
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
+	}
+	return entries
+}
+
+// SortedKeys() returns a slice containing all keys currently stored in the
+// dictionary, sorted according to the given comparator.
+//
+// Parameters:
+//   - less: A function that reports whether key a should be placed before key b.
+//
+// Returns:
+//   - A sorted slice of keys.
+func (d *Dictionary[K, V]) SortedKeys(less func(a, b K) bool) []K {
+	keys := d.Keys()
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	return keys
+}
+
+// SortedEntries() returns a slice containing all key-value pairs currently stored
+// in the dictionary, sorted by key according to the given comparator.
+//
+// Parameters:
+//   - less: A function that reports whether key a should be placed before key b.
+//
+// Returns:
+//   - A slice of entries sorted by key.
+func (d *Dictionary[K, V]) SortedEntries(less func(a, b K) bool) []Entry[K, V] {
+	entries := d.entries()
+	sort.Slice(entries, func(i, j int) bool { return less(entries[i].Key, entries[j].Key) })
+	return entries
+}
+
+// KeysByValue() returns a slice containing all keys in the dictionary, sorted by
+// the values associated with them. Keys with equal values may appear in any order
+// relative to each other.
+//
+// Parameters:
+//   - less: A function that reports whether value a should be placed before value
+//     b.
+//
+// Returns:
+//   - A slice of keys sorted by their values.
+func (d *Dictionary[K, V]) KeysByValue(less func(a, b V) bool) []K {
+	entries := d.entries()
+	sort.Slice(entries, func(i, j int) bool { return less(entries[i].Value, entries[j].Value) })
+	keys := make([]K, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, entry.Key)
+	}
+	return keys
+}
+
 // String() returns a string representation of the dictionary's contents.
 //
 // Returns:
@@ -149,7 +261,234 @@ func (d *Dictionary[K, V]) String() string {
 	return result
 }
 
+// StringSorted() returns a string representation of the dictionary's contents in
+// the same format as String(), but with the entries listed in the order given by
+// the comparator, so the output is deterministic.
+//
+// Parameters:
+//   - less: A function that reports whether key a should be placed before key b.
+//
+// Returns:
+//   - A formatted string listing all key-value pairs in key order, or an empty
+//     dictionary message.
+func (d *Dictionary[K, V]) StringSorted(less func(a, b K) bool) string {
+	if d.Size() == 0 {
+		return "Dictionary: {}"
+	}
+	result := "Dictionary: {\n"
+	for _, entry := range d.SortedEntries(less) {
+		result += fmt.Sprintf("  %v: %v\n", entry.Key, entry.Value)
+	}
+	result += "}"
+	return result
+}
+
 // Clear() removes all entries from the dictionary, resetting it to an empty state.
 func (d *Dictionary[K, V]) Clear() {
 	d.dict = make(map[K]V)
 }
+
+// PutAll() inserts every key-value pair from the given map, overwriting the values
+// of keys that are already present. A nil map is a no-op.
+//
+// Parameters:
+//   - m: The map whose entries are to be inserted.
+//
+// Returns:
+//   - The number of keys that were newly added.
+func (d *Dictionary[K, V]) PutAll(m map[K]V) int {
+	added := 0
+	for key, value := range m {
+		if !d.Put(key, value) {
+			added++
+		}
+	}
+	return added
+}
+
+// CopyInto() puts every entry of the dictionary into the target dictionary,
+// overwriting the values of the keys that already exist there. If either
+// dictionary is nil, nothing is copied.
+//
+// Parameters:
+//   - target: The dictionary that receives the entries.
+func (d *Dictionary[K, V]) CopyInto(target *Dictionary[K, V]) {
+	if d == nil || target == nil {
+		return
+	}
+	target.PutAll(d.dict)
+}
+
+// ContainsValue() checks whether any key in the dictionary is associated with a
+// value equal to the given one. Values are not indexed, so this operation takes
+// O(n) time.
+//
+// Parameters:
+//   - value: The value to search for.
+//   - equal: A function that reports whether two values are equal.
+//
+// Returns:
+//   - true if an equal value is found.
+//   - false otherwise.
+func (d *Dictionary[K, V]) ContainsValue(value V, equal func(a, b V) bool) bool {
+	for _, current := range d.dict {
+		if equal(current, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsValueComparable[K, V comparable]() checks whether any key in the
+// dictionary is associated with the given value, comparing values with ==. This
+// operation takes O(n) time.
+//
+// Parameters:
+//   - d: The dictionary to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - true if the value is found.
+//   - false otherwise.
+func ContainsValueComparable[K, V comparable](d *Dictionary[K, V], value V) bool {
+	return d.ContainsValue(value, func(a, b V) bool { return a == b })
+}
+
+// Invert[K, V comparable]() returns a new dictionary keyed by the values of the
+// given dictionary, mapping each of them back to its key. When several keys share
+// the same value, only one of them is kept and which one is unspecified.
+//
+// Parameters:
+//   - d: The dictionary to invert.
+//
+// Returns:
+//   - A pointer to a new Dictionary mapping values to keys.
+func Invert[K, V comparable](d *Dictionary[K, V]) *Dictionary[V, K] {
+	inverted := NewDictionary[V, K]()
+	for key, value := range d.dict {
+		inverted.Put(value, key)
+	}
+	return inverted
+}
+
+// KeySet[K comparable, V any]() returns a set containing the keys of the given
+// dictionary, so they can be combined with set operations such as Union() or
+// Intersection().
+//
+// Parameters:
+//   - d: The dictionary whose keys are to be collected.
+//
+// Returns:
+//   - A pointer to a new Set containing every key of the dictionary.
+func KeySet[K comparable, V any](d *Dictionary[K, V]) *set.Set[K] {
+	return set.NewSet(d.Keys()...)
+}
+
+// RemoveIf() deletes every entry that satisfies the given predicate.
+//
+// Parameters:
+//   - predicate: A function that reports whether a key-value pair should be
+//     removed.
+//
+// Returns:
+//   - The number of entries removed, or 0 if the dictionary is nil.
+func (d *Dictionary[K, V]) RemoveIf(predicate func(K, V) bool) int {
+	if d == nil {
+		return 0
+	}
+	removed := 0
+	for key, value := range d.dict {
+		if predicate(key, value) {
+			delete(d.dict, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// GetMany() retrieves the values associated with the specified keys. Keys that do
+// not exist in the dictionary are silently skipped.
+//
+// Parameters:
+//   - keys: The keys whose values are to be retrieved.
+//
+// Returns:
+//   - A map from each found key to its value.
+func (d *Dictionary[K, V]) GetMany(keys []K) map[K]V {
+	found := make(map[K]V, len(keys))
+	for _, key := range keys {
+		if value, exists := d.dict[key]; exists {
+			found[key] = value
+		}
+	}
+	return found
+}
+
+// PutEntries() inserts or updates every given key-value pair, in order.
+//
+// Parameters:
+//   - entries: The key-value pairs to insert.
+func (d *Dictionary[K, V]) PutEntries(entries []Entry[K, V]) {
+	for _, entry := range entries {
+		d.dict[entry.Key] = entry.Value
+	}
+}
+
+// Compute() updates the value associated with the specified key in a single call.
+// The given function receives the current value and whether the key exists, and
+// returns the new value along with whether the key should be kept. Returning false
+// removes the key from the dictionary.
+//
+// Parameters:
+//   - key: The key whose value is to be computed.
+//   - f: A function that computes the new value from the current one.
+//
+// Returns:
+//   - The new value associated with the key, or the zero value if it was removed.
+//   - true if the key is present after the operation, false otherwise.
+func (d *Dictionary[K, V]) Compute(key K, f func(current V, exists bool) (V, bool)) (V, bool) {
+	current, exists := d.dict[key]
+	value, keep := f(current, exists)
+	if !keep {
+		delete(d.dict, key)
+		var zero V
+		return zero, false
+	}
+	d.dict[key] = value
+	return value, true
+}
+
+// Update() modifies the value associated with the specified key in place. Since
+// values stored in a Go map are not addressable, the current value is loaded, the
+// given function mutates it through a pointer, and the result is stored back. If
+// the key does not exist, the function is not called.
+//
+// Parameters:
+//   - key: The key whose value is to be updated.
+//   - f: A function that mutates the value through the given pointer.
+//
+// Returns:
+//   - true if the key exists and its value was updated.
+//   - false if the key does not exist.
+func (d *Dictionary[K, V]) Update(key K, f func(value *V)) bool {
+	value, exists := d.dict[key]
+	if !exists {
+		return false
+	}
+	f(&value)
+	d.dict[key] = value
+	return true
+}
+
+// DistinctValues[K, V comparable]() returns each value stored in the dictionary
+// exactly once, in no particular order.
+//
+// Parameters:
+//   - d: The dictionary whose values are to be collected.
+//
+// Returns:
+//   - A slice of distinct values.
+func DistinctValues[K, V comparable](d *Dictionary[K, V]) []V {
+	values, _ := set.NewSet(d.Values()...).Values()
+	return values
+}
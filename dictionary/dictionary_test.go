@@ -16,6 +16,21 @@
 //   - Retrieve all keys or values as slices.
 //   - Clear all key-value pairs in the dictionary.
 //   - Get a string representation of the dictionary contents.
+//   - Insert all the entries of a Go map at once.
+//   - Check whether a value is present in the dictionary.
+//   - Invert a dictionary by swapping its keys and values.
+//   - Get the keys as a set for use with set operations.
+//   - Remove every entry matching a predicate.
+//   - Retrieve or store several entries in a single call.
+//   - Compute, update or delete the value of a key in a single call.
+//   - Retrieve the distinct values without duplicates.
+//   - Retrieve the keys or entries sorted by a comparator.
+//   - Check whether the dictionary is empty.
+//   - Update a stored value in place through a pointer.
+//   - Copy all entries into another dictionary.
+//   - Get a string representation with the keys in a stable order.
+//   - Retrieve the keys sorted by their values.
+//   - Convert entries into generic pairs from the tuple package.
 //
 // Most methods return an error if the dictionary receiver is nil.
 package dictionary
@@ -242,3 +257,318 @@ func TestDictionaryPerformance(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Value 999999", value)
 }
+
+// TestDictionaryPutAll() verifies that PutAll() inserts new keys, overwrites
+// existing ones and reports how many keys were newly added.
+func TestDictionaryPutAll(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("Leo", 55)
+	added := dict.PutAll(map[string]int{"Leo": 60, "Lucas": 38, "Fede": 20})
+	assert.Equal(t, 2, added)
+	assert.Equal(t, 3, dict.Size())
+	value, err := dict.Get("Leo")
+	assert.NoError(t, err)
+	assert.Equal(t, 60, value)
+	added = dict.PutAll(nil)
+	assert.Equal(t, 0, added)
+	assert.Equal(t, 3, dict.Size())
+}
+
+// TestDictionaryContainsValue() verifies that ContainsValue() finds struct values
+// using the supplied equality function.
+func TestDictionaryContainsValue(t *testing.T) {
+	dict := NewDictionary[string, Person]()
+	dict.Put("a", Person{Name: "Alice", Age: 30})
+	dict.Put("b", Person{Name: "Bob", Age: 25})
+	sameName := func(a, b Person) bool { return a.Name == b.Name }
+	assert.True(t, dict.ContainsValue(Person{Name: "Bob"}, sameName))
+	assert.False(t, dict.ContainsValue(Person{Name: "Carol"}, sameName))
+}
+
+// TestDictionaryContainsValueComparable() verifies that ContainsValueComparable()
+// finds primitive values using ==.
+func TestDictionaryContainsValueComparable(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("Leo", 55)
+	dict.Put("Lucas", 38)
+	assert.True(t, ContainsValueComparable(dict, 38))
+	assert.False(t, ContainsValueComparable(dict, 40))
+	assert.False(t, ContainsValueComparable(NewDictionary[string, int](), 0))
+}
+
+// TestDictionaryInvert() verifies that Invert() maps each value back to its key
+// for a bijective dictionary.
+func TestDictionaryInvert(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("one", 1)
+	dict.Put("two", 2)
+	dict.Put("three", 3)
+	inverted := Invert(dict)
+	assert.Equal(t, 3, inverted.Size())
+	for _, key := range dict.Keys() {
+		value, _ := dict.Get(key)
+		back, err := inverted.Get(value)
+		assert.NoError(t, err)
+		assert.Equal(t, key, back)
+	}
+}
+
+// TestDictionaryInvertWithDuplicateValues() ensures that Invert() keeps one of the
+// colliding keys when several keys share the same value.
+func TestDictionaryInvertWithDuplicateValues(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("Leo", 55)
+	dict.Put("Lucas", 55)
+	dict.Put("Fede", 20)
+	inverted := Invert(dict)
+	assert.Equal(t, 2, inverted.Size())
+	key, err := inverted.Get(55)
+	assert.NoError(t, err)
+	assert.Contains(t, []string{"Leo", "Lucas"}, key)
+	key, err = inverted.Get(20)
+	assert.NoError(t, err)
+	assert.Equal(t, "Fede", key)
+}
+
+// TestDictionaryKeySet() verifies that KeySet() returns the keys of a dictionary
+// as a set that can be intersected with the keys of another dictionary.
+func TestDictionaryKeySet(t *testing.T) {
+	a := NewDictionary[string, int]()
+	a.Put("Leo", 55)
+	a.Put("Lucas", 38)
+	a.Put("Fede", 20)
+	b := NewDictionary[string, bool]()
+	b.Put("Lucas", true)
+	b.Put("Fede", false)
+	b.Put("Ana", true)
+	keys := KeySet(a)
+	size, err := keys.Size()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, size)
+	common, err := keys.Intersection(KeySet(b))
+	assert.NoError(t, err)
+	values, err := common.Values()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"Lucas", "Fede"}, values)
+}
+
+// TestDictionaryRemoveIf() verifies that RemoveIf() deletes the entries matching a
+// condition on either the key or the value and reports how many were removed.
+func TestDictionaryRemoveIf(t *testing.T) {
+	dict := NewDictionary[int, string]()
+	for i := range 6 {
+		dict.Put(i, fmt.Sprintf("Value %d", i%3))
+	}
+	removed := dict.RemoveIf(func(key int, value string) bool { return key%2 == 0 })
+	assert.Equal(t, 3, removed)
+	assert.ElementsMatch(t, []int{1, 3, 5}, dict.Keys())
+	removed = dict.RemoveIf(func(key int, value string) bool { return value == "Value 2" })
+	assert.Equal(t, 1, removed)
+	assert.ElementsMatch(t, []int{1, 3}, dict.Keys())
+	removed = dict.RemoveIf(func(key int, value string) bool { return false })
+	assert.Equal(t, 0, removed)
+	var nilDict *Dictionary[int, string]
+	assert.Equal(t, 0, nilDict.RemoveIf(func(key int, value string) bool { return true }))
+}
+
+// TestDictionaryGetMany() verifies that GetMany() returns the values of the found
+// keys and skips the missing ones.
+func TestDictionaryGetMany(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("Leo", 55)
+	dict.Put("Lucas", 38)
+	dict.Put("Fede", 20)
+	found := dict.GetMany([]string{"Leo", "Ana", "Fede", "Juan"})
+	assert.Equal(t, map[string]int{"Leo": 55, "Fede": 20}, found)
+	assert.Empty(t, dict.GetMany(nil))
+}
+
+// TestDictionaryPutEntries() verifies that PutEntries() inserts new entries and
+// overwrites existing ones.
+func TestDictionaryPutEntries(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("Leo", 55)
+	dict.PutEntries([]Entry[string, int]{
+		{Key: "Leo", Value: 60},
+		{Key: "Lucas", Value: 38},
+	})
+	assert.Equal(t, 2, dict.Size())
+	value, err := dict.Get("Leo")
+	assert.NoError(t, err)
+	assert.Equal(t, 60, value)
+	value, err = dict.Get("Lucas")
+	assert.NoError(t, err)
+	assert.Equal(t, 38, value)
+}
+
+// TestDictionaryCompute() verifies the insert, update and delete paths of
+// Compute().
+func TestDictionaryCompute(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	increment := func(current int, exists bool) (int, bool) { return current + 1, true }
+	value, present := dict.Compute("hits", increment)
+	assert.True(t, present)
+	assert.Equal(t, 1, value)
+	value, present = dict.Compute("hits", increment)
+	assert.True(t, present)
+	assert.Equal(t, 2, value)
+	stored, err := dict.Get("hits")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, stored)
+	value, present = dict.Compute("hits", func(current int, exists bool) (int, bool) {
+		assert.True(t, exists)
+		return 0, current < 2
+	})
+	assert.False(t, present)
+	assert.Equal(t, 0, value)
+	assert.False(t, dict.Contains("hits"))
+	_, present = dict.Compute("missing", func(current int, exists bool) (int, bool) {
+		assert.False(t, exists)
+		return current, exists
+	})
+	assert.False(t, present)
+	assert.Equal(t, 0, dict.Size())
+}
+
+// TestDictionaryDistinctValues() verifies that DistinctValues() returns each
+// repeated value only once.
+func TestDictionaryDistinctValues(t *testing.T) {
+	dict := NewDictionary[string, string]()
+	dict.Put("Leo", "blue")
+	dict.Put("Lucas", "red")
+	dict.Put("Fede", "blue")
+	dict.Put("Ana", "green")
+	dict.Put("Juan", "red")
+	assert.ElementsMatch(t, []string{"blue", "red", "green"}, DistinctValues(dict))
+	assert.Empty(t, DistinctValues(NewDictionary[string, string]()))
+}
+
+// TestDictionaryErrKeyNotFound() verifies that Get() on a missing key returns an
+// error matching ErrKeyNotFound.
+func TestDictionaryErrKeyNotFound(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	_, err := dict.Get("Fede")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+// TestDictionaryEntries() verifies that entries() returns every key-value pair.
+func TestDictionaryEntries(t *testing.T) {
+	dict := NewDictionary[int, string]()
+	dict.Put(1, "one")
+	dict.Put(2, "two")
+	assert.ElementsMatch(t, []Entry[int, string]{{Key: 1, Value: "one"}, {Key: 2, Value: "two"}}, dict.entries())
+}
+
+// TestDictionarySortedKeysAndEntries() verifies that SortedKeys() and
+// SortedEntries() follow the order given by the comparator.
+func TestDictionarySortedKeysAndEntries(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("Lucas", 38)
+	dict.Put("Ana", 20)
+	dict.Put("Leo", 55)
+	ascending := func(a, b string) bool { return a < b }
+	descending := func(a, b string) bool { return a > b }
+	assert.Equal(t, []string{"Ana", "Leo", "Lucas"}, dict.SortedKeys(ascending))
+	assert.Equal(t, []string{"Lucas", "Leo", "Ana"}, dict.SortedKeys(descending))
+	expected := []Entry[string, int]{
+		{Key: "Ana", Value: 20},
+		{Key: "Leo", Value: 55},
+		{Key: "Lucas", Value: 38},
+	}
+	assert.Equal(t, expected, dict.SortedEntries(ascending))
+}
+
+// TestDictionaryIsEmpty() verifies that IsEmpty() reports true for empty and nil
+// dictionaries and false once an entry is added.
+func TestDictionaryIsEmpty(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	assert.True(t, dict.IsEmpty())
+	dict.Put("Leo", 55)
+	assert.False(t, dict.IsEmpty())
+	dict.Remove("Leo")
+	assert.True(t, dict.IsEmpty())
+	var nilDict *Dictionary[string, int]
+	assert.True(t, nilDict.IsEmpty())
+}
+
+// TestDictionaryUpdate() verifies that Update() mutates a stored struct value
+// through a pointer and leaves missing keys untouched.
+func TestDictionaryUpdate(t *testing.T) {
+	dict := NewDictionary[string, Person]()
+	dict.Put("leo", Person{Name: "Leo", Age: 55})
+	updated := dict.Update("leo", func(p *Person) {
+		p.Age++
+		p.Name = "Leonardo"
+	})
+	assert.True(t, updated)
+	value, err := dict.Get("leo")
+	assert.NoError(t, err)
+	assert.Equal(t, Person{Name: "Leonardo", Age: 56}, value)
+	called := false
+	updated = dict.Update("ana", func(p *Person) { called = true })
+	assert.False(t, updated)
+	assert.False(t, called)
+	assert.False(t, dict.Contains("ana"))
+}
+
+// TestDictionaryCopyInto() verifies that CopyInto() adds the entries to the target,
+// overwrites colliding keys, keeps the rest of the target, and ignores a nil
+// target.
+func TestDictionaryCopyInto(t *testing.T) {
+	source := NewDictionary[string, int]()
+	source.Put("Leo", 55)
+	source.Put("Lucas", 38)
+	target := NewDictionary[string, int]()
+	target.Put("Leo", 1)
+	target.Put("Fede", 20)
+	source.CopyInto(target)
+	assert.Equal(t, 3, target.Size())
+	value, _ := target.Get("Leo")
+	assert.Equal(t, 55, value)
+	value, _ = target.Get("Fede")
+	assert.Equal(t, 20, value)
+	assert.Equal(t, 2, source.Size())
+	assert.NotPanics(t, func() { source.CopyInto(nil) })
+	assert.Equal(t, 2, source.Size())
+}
+
+// TestDictionaryStringSorted() verifies that StringSorted() lists the entries in
+// the order given by the comparator.
+func TestDictionaryStringSorted(t *testing.T) {
+	dict := NewDictionary[string, int]()
+	dict.Put("Lucas", 38)
+	dict.Put("Fede", 20)
+	dict.Put("Leo", 55)
+	ascending := func(a, b string) bool { return a < b }
+	assert.Equal(t, "Dictionary: {\n  Fede: 20\n  Leo: 55\n  Lucas: 38\n}", dict.StringSorted(ascending))
+	descending := func(a, b string) bool { return a > b }
+	assert.Equal(t, "Dictionary: {\n  Lucas: 38\n  Leo: 55\n  Fede: 20\n}", dict.StringSorted(descending))
+	assert.Equal(t, "Dictionary: {}", NewDictionary[string, int]().StringSorted(ascending))
+}
+
+// TestDictionaryKeysByValue() verifies that KeysByValue() orders the keys by their
+// associated values according to the comparator.
+func TestDictionaryKeysByValue(t *testing.T) {
+	scores := NewDictionary[string, int]()
+	scores.Put("Leo", 55)
+	scores.Put("Lucas", 38)
+	scores.Put("Fede", 90)
+	scores.Put("Ana", 72)
+	highestFirst := func(a, b int) bool { return a > b }
+	assert.Equal(t, []string{"Fede", "Ana", "Leo", "Lucas"}, scores.KeysByValue(highestFirst))
+	lowestFirst := func(a, b int) bool { return a < b }
+	assert.Equal(t, []string{"Lucas", "Leo", "Ana", "Fede"}, scores.KeysByValue(lowestFirst))
+	scores.Put("Juan", 55)
+	tied := scores.KeysByValue(lowestFirst)
+	assert.Equal(t, "Lucas", tied[0])
+	assert.ElementsMatch(t, []string{"Leo", "Juan"}, tied[1:3])
+	assert.Empty(t, NewDictionary[string, int]().KeysByValue(lowestFirst))
+}
+
+// TestDictionaryEntryPair() verifies that Pair() converts an entry into a
+// tuple.Pair holding the key first and the value second.
+func TestDictionaryEntryPair(t *testing.T) {
+	pair := Entry[string, int]{Key: "Leo", Value: 55}.Pair()
+	assert.Equal(t, "Leo", pair.First)
+	assert.Equal(t, 55, pair.Second)
+}
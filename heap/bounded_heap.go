@@ -0,0 +1,97 @@
+// Package heap provides a generic implementation of a binary heap data structure,
+// supporting both min-heap and max-heap configurations.
+//
+// A heap is a complete binary tree where the value of each node is ordered with
+// respect to its children according to a comparator function. This package allows
+// storing elements of any type and defines custom behavior via a comparator
+// function.
+//
+// Included features:
+//   - Create a generic heap using a custom comparator.
+//   - Create a min-heap or max-heap.
+//   - Insert elements into the heap.
+//   - Remove and return the root element (minimum or maximum depending on the
+//     heap).
+//   - Retrieve the current size of the heap.
+//   - Get a copy of the elements in internal order for inspection or testing
+//     purposes.
+//   - Inspect the top k elements in extraction order without removing them.
+//   - Replace the root element with a new one in a single sift.
+//   - Get a string representation of the heap contents.
+//   - Replace the comparator at runtime, rebuilding the heap.
+//   - Keep only the k greatest elements seen with a bounded heap.
+//   - Remove an arbitrary element by index.
+//   - Insert a batch of elements at once.
+//   - Find the k-th element in extraction order without draining the heap.
+//   - Check whether the heap property holds.
+//   - Drain the heap in extraction order into a reusable buffer.
+//   - Get a sorted view of the elements without modifying the heap.
+//   - Push and Pop aliases for Insert and Remove.
+//   - Release unused memory as the heap shrinks after removals.
+//   - Track the index of each element through a callback.
+//   - Restore the heap property after an element changes in place.
+//   - Iterate lazily over the elements in extraction order.
+//
+// The implementation ensures the heap property is maintained on insertions and
+// removals using up-heap and down-heap operations.
+package heap
+
+// BoundedHeap[T any] represents a heap that retains at most k elements, keeping
+// the k greatest ones according to its comparator. To keep the k smallest
+// elements instead, provide a comparator with the inverted order.
+type BoundedHeap[T any] struct {
+	heap *Heap[T]
+	k    int
+}
+
+// NewBoundedHeap() creates and returns a new bounded heap that retains at most k
+// elements.
+//
+// Parameters:
+//   - compare: A function that compares two elements. It should return:
+//   - A negative value if a < b
+//   - Zero if a == b
+//   - A positive value if a > b
+//   - k: The maximum number of elements to retain.
+//
+// Returns:
+//   - A pointer to a new BoundedHeap instance.
+func NewBoundedHeap[T any](compare func(a, b T) int, k int) *BoundedHeap[T] {
+	return &BoundedHeap[T]{heap: NewMinHeap(compare), k: k}
+}
+
+// Insert() offers a new element to the bounded heap. Once k elements are retained,
+// the element is only kept if it is greater than the smallest retained one, which
+// is then dropped.
+//
+// Parameters:
+//   - element: The value to insert.
+func (b *BoundedHeap[T]) Insert(element T) {
+	if b.k <= 0 {
+		return
+	}
+	if b.heap.Size() < b.k {
+		b.heap.Insert(element)
+		return
+	}
+	if root, _ := b.heap.Peek(); b.heap.Comparator()(element, root) > 0 {
+		b.heap.Replace(element)
+	}
+}
+
+// Elements() returns a slice containing the currently retained elements, in
+// internal heap order.
+//
+// Returns:
+//   - A slice with at most k elements.
+func (b *BoundedHeap[T]) Elements() []T {
+	return b.heap.Elements()
+}
+
+// Size() returns the number of elements currently retained.
+//
+// Returns:
+//   - An integer representing the number of retained elements.
+func (b *BoundedHeap[T]) Size() int {
+	return b.heap.Size()
+}
@@ -0,0 +1,78 @@
+// Package heap provides a generic implementation of a binary heap data structure,
+// supporting both min-heap and max-heap configurations.
+//
+// A heap is a complete binary tree where the value of each node is ordered with
+// respect to its children according to a comparator function. This package allows
+// storing elements of any type and defines custom behavior via a comparator
+// function.
+//
+// Included features:
+//   - Create a generic heap using a custom comparator.
+//   - Create a min-heap or max-heap.
+//   - Insert elements into the heap.
+//   - Remove and return the root element (minimum or maximum depending on the
+//     heap).
+//   - Retrieve the current size of the heap.
+//   - Get a copy of the elements in internal order for inspection or testing
+//     purposes.
+//   - Inspect the top k elements in extraction order without removing them.
+//   - Replace the root element with a new one in a single sift.
+//   - Get a string representation of the heap contents.
+//   - Replace the comparator at runtime, rebuilding the heap.
+//   - Keep only the k greatest elements seen with a bounded heap.
+//   - Remove an arbitrary element by index.
+//   - Insert a batch of elements at once.
+//   - Find the k-th element in extraction order without draining the heap.
+//   - Check whether the heap property holds.
+//   - Drain the heap in extraction order into a reusable buffer.
+//   - Get a sorted view of the elements without modifying the heap.
+//   - Push and Pop aliases for Insert and Remove.
+//   - Release unused memory as the heap shrinks after removals.
+//   - Track the index of each element through a callback.
+//   - Restore the heap property after an element changes in place.
+//   - Iterate lazily over the elements in extraction order.
+//
+// The implementation ensures the heap property is maintained on insertions and
+// removals using up-heap and down-heap operations.
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBoundedHeapRetainsTopK() streams 1000 numbers through a bounded heap of size
+// 10 and verifies that it retains the 10 greatest.
+func TestBoundedHeapRetainsTopK(t *testing.T) {
+	b := NewBoundedHeap(intComparator, 10)
+	numbers := rand.New(rand.NewSource(1)).Perm(1000)
+	for _, n := range numbers {
+		b.Insert(n)
+		assert.LessOrEqual(t, b.Size(), 10)
+	}
+	retained := b.Elements()
+	sort.Ints(retained)
+	assert.Equal(t, []int{990, 991, 992, 993, 994, 995, 996, 997, 998, 999}, retained)
+}
+
+// TestBoundedHeapSmallest() verifies that an inverted comparator makes the bounded
+// heap retain the smallest elements instead.
+func TestBoundedHeapSmallest(t *testing.T) {
+	b := NewBoundedHeap(func(a, b int) int { return intComparator(b, a) }, 3)
+	for _, n := range []int{44, 29, 58, 2, 98, 11, 65, 3} {
+		b.Insert(n)
+	}
+	assert.ElementsMatch(t, []int{2, 3, 11}, b.Elements())
+}
+
+// TestBoundedHeapZeroCapacity() ensures that a bounded heap with k equal to zero
+// never retains elements.
+func TestBoundedHeapZeroCapacity(t *testing.T) {
+	b := NewBoundedHeap(intComparator, 0)
+	b.Insert(1)
+	assert.Equal(t, 0, b.Size())
+	assert.Empty(t, b.Elements())
+}
@@ -10,22 +10,61 @@
 //   - Create a generic heap using a custom comparator.
 //   - Create a min-heap or max-heap.
 //   - Insert elements into the heap.
-//   - Remove and return the root element (minimun or maximum depending oh the
+//   - Remove and return the root element (minimum or maximum depending on the
 //     heap).
 //   - Retrieve the current size of the heap.
-//   - Access the internal slice of elements for inspection or testing purposes.
+//   - Get a copy of the elements in internal order for inspection or testing
+//     purposes.
+//   - Inspect the top k elements in extraction order without removing them.
+//   - Replace the root element with a new one in a single sift.
+//   - Get a string representation of the heap contents.
+//   - Replace the comparator at runtime, rebuilding the heap.
+//   - Keep only the k greatest elements seen with a bounded heap.
+//   - Remove an arbitrary element by index.
+//   - Insert a batch of elements at once.
+//   - Find the k-th element in extraction order without draining the heap.
+//   - Check whether the heap property holds.
+//   - Drain the heap in extraction order into a reusable buffer.
+//   - Get a sorted view of the elements without modifying the heap.
+//   - Push and Pop aliases for Insert and Remove.
+//   - Release unused memory as the heap shrinks after removals.
+//   - Track the index of each element through a callback.
+//   - Restore the heap property after an element changes in place.
+//   - Iterate lazily over the elements in extraction order.
 //
 // The implementation ensures the heap property is maintained on insertions and
 // removals using up-heap and down-heap operations.
 package heap
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmptyHeap is returned when an element is removed or inspected from an
+	// empty heap.
+	ErrEmptyHeap = errors.New("empty heap")
+	// ErrIndexOutOfRange is returned when an element is accessed at an index
+	// outside the heap.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrNegativeK is returned when a negative number of elements is requested.
+	ErrNegativeK = errors.New("negative k")
+	// ErrKOutOfRange is returned when a position in extraction order is less than
+	// 1 or greater than the size of the heap.
+	ErrKOutOfRange = errors.New("k out of range")
+)
+
+// minShrinkCapacity is the capacity below which the internal slice is never
+// reallocated after a removal.
+const minShrinkCapacity = 16
 
 // Heap[T any] represents a generic binary heap that stores elements of type T. The
 // ordering of elements is determined by the provided compare function.
 type Heap[T any] struct {
-	elements []T
-	compare  func(a T, b T) int
+	elements  []T
+	compare   func(a T, b T) int
+	indexHook func(element T, index int)
 }
 
 // NewGenericHeap() creates and returns a new generic heap using the provided
@@ -88,6 +127,7 @@ func (h *Heap[T]) Size() int {
 //   - element: The value to insert into the heap.
 func (h *Heap[T]) Insert(element T) {
 	h.elements = append(h.elements, element)
+	h.notify(len(h.elements) - 1)
 	h.upHeap(len(h.elements) - 1)
 }
 
@@ -98,23 +138,76 @@ func (h *Heap[T]) Insert(element T) {
 //   - The removed element.
 //   - An error if the heap is empty.
 func (h *Heap[T]) Remove() (T, error) {
+	element, err := h.removeRoot()
+	if err != nil {
+		return element, err
+	}
+	h.shrink()
+	return element, nil
+}
+
+// removeRoot() removes and returns the root element like Remove(), but never
+// shrinks the internal slice. It is used by the operations that remove many
+// elements in a row, where shrinking on every removal would reallocate repeatedly.
+//
+// Returns:
+//   - The removed element.
+//   - An error if the heap is empty.
+func (h *Heap[T]) removeRoot() (T, error) {
 	var element T
 	if h.Size() == 0 {
-		return element, errors.New("empty heap")
+		return element, ErrEmptyHeap
 	}
 	element = h.elements[0]
 	h.elements[0] = h.elements[h.Size()-1]
 	h.elements = h.elements[:h.Size()-1]
+	if h.Size() > 0 {
+		h.notify(0)
+	}
 	h.downHeap(0)
 	return element, nil
 }
 
-// Elements() returns a slice containing all elements in the heap.
+// Push() is an alias for Insert(), provided for consistency with the stack and
+// queue packages.
+//
+// Parameters:
+//   - element: The value to insert into the heap.
+func (h *Heap[T]) Push(element T) {
+	h.Insert(element)
+}
+
+// Pop() is an alias for Remove(), provided for consistency with the stack and
+// queue packages.
+//
+// Returns:
+//   - The removed element.
+//   - An error if the heap is empty.
+func (h *Heap[T]) Pop() (T, error) {
+	return h.Remove()
+}
+
+// shrink() reallocates the internal slice with half its capacity when fewer than
+// a quarter of it is in use, so that heaps that shrink dramatically release their
+// memory. Small slices are left untouched to avoid reallocating too often.
+func (h *Heap[T]) shrink() {
+	if cap(h.elements) <= minShrinkCapacity || len(h.elements) >= cap(h.elements)/4 {
+		return
+	}
+	elements := make([]T, len(h.elements), cap(h.elements)/2)
+	copy(elements, h.elements)
+	h.elements = elements
+}
+
+// Elements() returns a copy of the elements in the heap, in internal array order.
+// Modifying the returned slice does not affect the heap.
 //
 // Returns:
-//   - A slice of elements currently in the heap.
+//   - A new slice with the elements currently in the heap.
 func (h *Heap[T]) Elements() []T {
-	return h.elements
+	elements := make([]T, len(h.elements))
+	copy(elements, h.elements)
+	return elements
 }
 
 // Peek() returns the root element of the heap without removing it.
@@ -126,7 +219,7 @@ func (h *Heap[T]) Elements() []T {
 func (h *Heap[T]) Peek() (T, error) {
 	if h.Size() == 0 {
 		var zero T
-		return zero, errors.New("empty heap")
+		return zero, ErrEmptyHeap
 	}
 	return h.elements[0], nil
 }
@@ -150,7 +243,7 @@ func (h *Heap[T]) downHeap(i int) {
 		if smallest == i {
 			break
 		}
-		h.elements[i], h.elements[smallest] = h.elements[smallest], h.elements[i]
+		h.swap(i, smallest)
 		i = smallest
 	}
 }
@@ -166,11 +259,45 @@ func (h *Heap[T]) upHeap(i int) {
 		if h.compare(h.elements[i], h.elements[parent]) > 0 {
 			break
 		}
-		h.elements[i], h.elements[parent] = h.elements[parent], h.elements[i]
+		h.swap(i, parent)
 		i = parent
 	}
 }
 
+// swap() exchanges the elements at the given indices and reports their new
+// positions to the index hook, if any.
+//
+// Parameters:
+//   - i: The index of the first element.
+//   - j: The index of the second element.
+func (h *Heap[T]) swap(i, j int) {
+	h.elements[i], h.elements[j] = h.elements[j], h.elements[i]
+	h.notify(i)
+	h.notify(j)
+}
+
+// notify() reports the element at the given index to the index hook, if any.
+//
+// Parameters:
+//   - i: The index of the element that was placed.
+func (h *Heap[T]) notify(i int) {
+	if h.indexHook != nil {
+		h.indexHook(h.elements[i], i)
+	}
+}
+
+// SetIndexHook() registers a function that is called every time an element is
+// placed at a new index of the internal slice, whether on insertion or while
+// restoring the heap property. This lets external structures track the position
+// of each element, for example to support decrease-key operations. Removed
+// elements are not reported. Passing nil removes the hook, which is the default.
+//
+// Parameters:
+//   - f: A function that receives an element and its new index.
+func (h *Heap[T]) SetIndexHook(f func(element T, index int)) {
+	h.indexHook = f
+}
+
 // Comparator() returns the comparison function used by the heap.
 //
 // Returns:
@@ -181,3 +308,250 @@ func (h *Heap[T]) upHeap(i int) {
 func (h *Heap[T]) Comparator() func(a, b T) int {
 	return h.compare
 }
+
+// TopK() returns up to k elements in the order they would be removed from the
+// heap, without modifying it. If k exceeds the size of the heap, all elements are
+// returned.
+//
+// Parameters:
+//   - k: The maximum number of elements to return.
+//
+// Returns:
+//   - A slice with up to k elements in extraction order.
+//   - An error if k is negative.
+func (h *Heap[T]) TopK(k int) ([]T, error) {
+	if k < 0 {
+		return nil, ErrNegativeK
+	}
+	if k > h.Size() {
+		k = h.Size()
+	}
+	clone := h.clone()
+	top := make([]T, 0, k)
+	for range k {
+		element, _ := clone.removeRoot()
+		top = append(top, element)
+	}
+	return top, nil
+}
+
+// clone() returns an independent copy of the heap that shares its comparator. The
+// index hook is not copied, so operations on the clone are not reported.
+//
+// Returns:
+//   - A pointer to a new Heap with the same elements in the same order.
+func (h *Heap[T]) clone() *Heap[T] {
+	elements := make([]T, len(h.elements))
+	copy(elements, h.elements)
+	return &Heap[T]{compare: h.compare, elements: elements}
+}
+
+// Replace() removes and returns the root element and inserts the given element in
+// a single sift-down operation. It is more efficient than calling Remove()
+// followed by Insert().
+//
+// Parameters:
+//   - element: The value to insert into the heap.
+//
+// Returns:
+//   - The removed root element.
+//   - An error if the heap is empty.
+func (h *Heap[T]) Replace(element T) (T, error) {
+	if h.Size() == 0 {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+	root := h.elements[0]
+	h.elements[0] = element
+	h.notify(0)
+	h.downHeap(0)
+	return root, nil
+}
+
+// String() returns a string representation of the heap showing its elements in
+// internal array order, which is useful for debugging purposes.
+//
+// Returns:
+//   - A string representing the current elements in the heap.
+func (h *Heap[T]) String() string {
+	return fmt.Sprintf("Heap: %v", h.elements)
+}
+
+// SetComparator() replaces the comparison function used by the heap and rebuilds
+// the heap in O(n) time so the heap property holds under the new ordering. The
+// comparator is used as-is, so passing it to a max-heap does not invert it. This
+// method is not safe for concurrent use.
+//
+// Parameters:
+//   - compare: A function that compares two elements. It should return:
+//   - A negative value if a < b
+//   - Zero if a == b
+//   - A positive value if a > b
+func (h *Heap[T]) SetComparator(compare func(a, b T) int) {
+	h.compare = compare
+	h.heapify()
+}
+
+// heapify() restores the heap property over the whole slice of elements by
+// sifting down every non-leaf node, starting from the last one.
+func (h *Heap[T]) heapify() {
+	for i := h.Size()/2 - 1; i >= 0; i-- {
+		h.downHeap(i)
+	}
+}
+
+// RemoveAt() removes and returns the element at the given index of the internal
+// slice. The last element takes its place and is sifted up or down as needed to
+// restore the heap property.
+//
+// Parameters:
+//   - index: The index of the element to remove.
+//
+// Returns:
+//   - The removed element.
+//   - An error if the index is out of range.
+func (h *Heap[T]) RemoveAt(index int) (T, error) {
+	var element T
+	if index < 0 || index >= h.Size() {
+		return element, ErrIndexOutOfRange
+	}
+	element = h.elements[index]
+	last := h.Size() - 1
+	h.elements[index] = h.elements[last]
+	h.elements = h.elements[:last]
+	if index < last {
+		h.notify(index)
+		h.downHeap(index)
+		h.upHeap(index)
+	}
+	h.shrink()
+	return element, nil
+}
+
+// Fix() restores the heap property after the element at the given index has
+// changed its ordering, for example when the priority of a pointer element is
+// updated in place. The element is sifted up or down as needed, which is cheaper
+// than removing it and inserting it again. Unlike Fix() in container/heap, which
+// panics on a bad index, an out-of-range index returns ErrIndexOutOfRange and
+// leaves the heap unchanged, as RemoveAt() does.
+//
+// Parameters:
+//   - index: The index of the element whose ordering has changed.
+//
+// Returns:
+//   - ErrIndexOutOfRange if the index is out of range.
+func (h *Heap[T]) Fix(index int) error {
+	if index < 0 || index >= h.Size() {
+		return ErrIndexOutOfRange
+	}
+	h.downHeap(index)
+	h.upHeap(index)
+	return nil
+}
+
+// InsertAll() adds several elements to the heap and restores the heap property.
+// When the batch is at least as large as the current heap, the whole heap is
+// rebuilt in O(n) time; otherwise each element is sifted up individually.
+//
+// Parameters:
+//   - elements: The values to insert into the heap.
+func (h *Heap[T]) InsertAll(elements []T) {
+	if len(elements) < h.Size() {
+		for _, element := range elements {
+			h.Insert(element)
+		}
+		return
+	}
+	start := h.Size()
+	h.elements = append(h.elements, elements...)
+	for i := start; i < h.Size(); i++ {
+		h.notify(i)
+	}
+	h.heapify()
+}
+
+// KthElement() returns the k-th element in the heap's ordering without modifying
+// it, where k equal to 1 is the root. It takes O(k log n) time.
+//
+// Parameters:
+//   - k: The one-based position of the element in extraction order.
+//
+// Returns:
+//   - The k-th element in extraction order.
+//   - An error if k is less than 1 or greater than the size of the heap.
+func (h *Heap[T]) KthElement(k int) (T, error) {
+	var element T
+	if k < 1 || k > h.Size() {
+		return element, ErrKOutOfRange
+	}
+	clone := h.clone()
+	for range k {
+		element, _ = clone.removeRoot()
+	}
+	return element, nil
+}
+
+// IsValid() checks whether the heap property holds, that is, whether no parent is
+// ordered after either of its children according to the comparator. It is mainly
+// useful for testing and debugging custom comparators.
+//
+// Returns:
+//   - true if the heap property holds for every element.
+//   - false if any violation is found.
+func (h *Heap[T]) IsValid() bool {
+	for i := 1; i < h.Size(); i++ {
+		if h.compare(h.elements[(i-1)/2], h.elements[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// DrainInto() removes every element from the heap in extraction order, appending
+// them to the given buffer and reusing its capacity, and leaves the heap empty.
+// The heap keeps the capacity of its internal slice, so refilling it after a
+// drain does not allocate again.
+//
+// Parameters:
+//   - buf: The slice the removed elements are appended to.
+//
+// Returns:
+//   - The buffer with the removed elements appended.
+//   - An error if an element could not be removed.
+func (h *Heap[T]) DrainInto(buf []T) ([]T, error) {
+	for h.Size() > 0 {
+		element, err := h.removeRoot()
+		if err != nil {
+			return buf, err
+		}
+		buf = append(buf, element)
+	}
+	return buf, nil
+}
+
+// SortedView() returns every element of the heap in extraction order without
+// modifying it. Unlike Elements(), which exposes the internal array order, the
+// result is fully sorted according to the heap's comparator.
+//
+// Returns:
+//   - A slice with all the elements in extraction order.
+func (h *Heap[T]) SortedView() []T {
+	sorted, _ := h.clone().DrainInto(make([]T, 0, h.Size()))
+	return sorted
+}
+
+// SortedIterator() returns a function that yields the elements of the heap in
+// extraction order, one per call, without modifying the heap. The elements are
+// taken lazily from a copy made when the iterator is created, so stopping early
+// avoids the cost of sorting the remaining elements.
+//
+// Returns:
+//   - A function that returns the next element and true, or the zero value and
+//     false once every element has been yielded.
+func (h *Heap[T]) SortedIterator() func() (T, bool) {
+	clone := h.clone()
+	return func() (T, bool) {
+		element, err := clone.removeRoot()
+		return element, err == nil
+	}
+}
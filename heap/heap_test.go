@@ -13,7 +13,24 @@
 //   - Remove and return the root element (minimum or maximum depending on the
 //     heap).
 //   - Retrieve the current size of the heap.
-//   - Access the internal slice of elements for inspection or testing purposes.
+//   - Get a copy of the elements in internal order for inspection or testing
+//     purposes.
+//   - Inspect the top k elements in extraction order without removing them.
+//   - Replace the root element with a new one in a single sift.
+//   - Get a string representation of the heap contents.
+//   - Replace the comparator at runtime, rebuilding the heap.
+//   - Keep only the k greatest elements seen with a bounded heap.
+//   - Remove an arbitrary element by index.
+//   - Insert a batch of elements at once.
+//   - Find the k-th element in extraction order without draining the heap.
+//   - Check whether the heap property holds.
+//   - Drain the heap in extraction order into a reusable buffer.
+//   - Get a sorted view of the elements without modifying the heap.
+//   - Push and Pop aliases for Insert and Remove.
+//   - Release unused memory as the heap shrinks after removals.
+//   - Track the index of each element through a callback.
+//   - Restore the heap property after an element changes in place.
+//   - Iterate lazily over the elements in extraction order.
 //
 // The implementation ensures the heap property is maintained on insertions and
 // removals using up-heap and down-heap operations.
@@ -212,3 +229,464 @@ func TestMinHeapCreateInsertAndExtract(t *testing.T) {
 		assert.Equal(t, orderExpectedAfterInsert[i], m.Elements())
 	}
 }
+
+// TestHeapTopK() verifies that TopK() returns the same elements as repeated calls
+// to Remove() and leaves the heap untouched.
+func TestHeapTopK(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3} {
+		m.Insert(v)
+	}
+	before := append([]int(nil), m.Elements()...)
+	top, err := m.TopK(3)
+	assert.NoError(t, err)
+	assert.Equal(t, before, m.Elements())
+	for _, expected := range top {
+		removed, err := m.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, removed)
+	}
+	assert.Equal(t, []int{2, 3, 11}, top)
+}
+
+// TestHeapTopKExceedingSize() ensures that TopK() returns every element when k is
+// larger than the heap, and an error when k is negative.
+func TestHeapTopKExceedingSize(t *testing.T) {
+	m := NewMaxHeap(intComparator)
+	m.Insert(1)
+	m.Insert(3)
+	m.Insert(2)
+	top, err := m.TopK(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 2, 1}, top)
+	assert.Equal(t, 3, m.Size())
+	_, err = m.TopK(-1)
+	assert.Error(t, err)
+}
+
+// TestHeapReplace() verifies that Replace() returns the same root and leaves the
+// heap yielding the same sequence as Remove() followed by Insert().
+func TestHeapReplace(t *testing.T) {
+	replaced := NewMinHeap(intComparator)
+	reference := NewMinHeap(intComparator)
+	for _, v := range []int{44, 29, 58, 2, 98, 11} {
+		replaced.Insert(v)
+		reference.Insert(v)
+	}
+	for _, v := range []int{50, 1, 70} {
+		got, err := replaced.Replace(v)
+		assert.NoError(t, err)
+		want, err := reference.Remove()
+		assert.NoError(t, err)
+		reference.Insert(v)
+		assert.Equal(t, want, got)
+	}
+	for reference.Size() > 0 {
+		want, _ := reference.Remove()
+		got, err := replaced.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+// TestHeapReplaceEmpty() ensures that Replace() returns an error on an empty heap.
+func TestHeapReplaceEmpty(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	_, err := m.Replace(1)
+	assert.Error(t, err)
+	assert.Equal(t, 0, m.Size())
+}
+
+// TestHeapString() verifies that the string representation of the heap includes
+// the "Heap:" prefix and all of its elements.
+func TestHeapString(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	assert.Equal(t, "Heap: []", m.String())
+	m.Insert(3)
+	m.Insert(1)
+	m.Insert(2)
+	str := m.String()
+	assert.Contains(t, str, "Heap: [")
+	assert.Contains(t, str, "1")
+	assert.Contains(t, str, "2")
+	assert.Contains(t, str, "3")
+}
+
+// TestHeapSetComparator() verifies that switching a min-heap to a descending
+// comparator rebuilds the heap so Remove() yields elements in descending order.
+func TestHeapSetComparator(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3} {
+		m.Insert(v)
+	}
+	m.SetComparator(func(a, b int) int { return intComparator(b, a) })
+	var removed []int
+	for m.Size() > 0 {
+		v, err := m.Remove()
+		assert.NoError(t, err)
+		removed = append(removed, v)
+	}
+	assert.Equal(t, []int{98, 65, 58, 44, 29, 11, 3, 2}, removed)
+}
+
+// TestHeapRemoveAt() verifies that RemoveAt() removes interior elements while
+// preserving the heap property.
+func TestHeapRemoveAt(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3, 68, 99} {
+		m.Insert(v)
+	}
+	expected := m.Elements()[4]
+	removed, err := m.RemoveAt(4)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, removed)
+	assertHeapProperty(t, m)
+	expected = m.Elements()[1]
+	removed, err = m.RemoveAt(1)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, removed)
+	assertHeapProperty(t, m)
+	last := m.Size() - 1
+	expected = m.Elements()[last]
+	removed, err = m.RemoveAt(last)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, removed)
+	assertHeapProperty(t, m)
+	assert.Equal(t, 7, m.Size())
+}
+
+// TestHeapRemoveAtOutOfRange() ensures that RemoveAt() returns an error for
+// invalid indices.
+func TestHeapRemoveAtOutOfRange(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	m.Insert(1)
+	_, err := m.RemoveAt(1)
+	assert.Error(t, err)
+	_, err = m.RemoveAt(-1)
+	assert.Error(t, err)
+	assert.Equal(t, 1, m.Size())
+}
+
+// assertHeapProperty() is a helper function that fails the test if any parent in
+// the heap is ordered after one of its children.
+func assertHeapProperty[T any](t *testing.T, h *Heap[T]) {
+	elements := h.Elements()
+	for i := 1; i < len(elements); i++ {
+		parent := (i - 1) / 2
+		assert.LessOrEqual(t, h.Comparator()(elements[parent], elements[i]), 0, "heap property violated at index %d", i)
+	}
+}
+
+// TestHeapInsertAll() verifies that the heap property holds after a mixed
+// sequence of InsertAll() and Insert() calls, with both large and small batches.
+func TestHeapInsertAll(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	m.InsertAll([]int{44, 29, 58, 2, 98, 11})
+	assertHeapProperty(t, m)
+	m.Insert(1)
+	m.InsertAll([]int{65, 3})
+	assertHeapProperty(t, m)
+	m.InsertAll([]int{68, 99, 0, 7, 5, 13, 21, 8, 4})
+	assertHeapProperty(t, m)
+	m.InsertAll(nil)
+	assert.Equal(t, 18, m.Size())
+	var removed []int
+	for m.Size() > 0 {
+		v, _ := m.Remove()
+		removed = append(removed, v)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 7, 8, 11, 13, 21, 29, 44, 58, 65, 68, 98, 99}, removed)
+}
+
+// TestHeapKthElement() verifies that KthElement() returns the root for k equal to
+// 1, the last element in extraction order for k equal to the size, and leaves the
+// heap untouched.
+func TestHeapKthElement(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3} {
+		m.Insert(v)
+	}
+	before := append([]int(nil), m.Elements()...)
+	first, err := m.KthElement(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, first)
+	third, err := m.KthElement(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, third)
+	last, err := m.KthElement(m.Size())
+	assert.NoError(t, err)
+	assert.Equal(t, 98, last)
+	assert.Equal(t, before, m.Elements())
+	_, err = m.KthElement(0)
+	assert.Error(t, err)
+	_, err = m.KthElement(m.Size() + 1)
+	assert.Error(t, err)
+}
+
+// TestHeapIsValid() verifies that IsValid() reports a valid heap after insertions
+// and removals, and an invalid one after its internal slice is corrupted.
+func TestHeapIsValid(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	assert.True(t, m.IsValid())
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3} {
+		m.Insert(v)
+	}
+	m.RemoveAt(3)
+	m.Remove()
+	assert.True(t, m.IsValid())
+	corruptHeap(m, 0, 1)
+	assert.False(t, m.IsValid())
+}
+
+// corruptHeap() is a helper function that swaps two elements of the internal
+// slice directly, bypassing the heap operations.
+func corruptHeap[T any](h *Heap[T], i, j int) {
+	h.elements[i], h.elements[j] = h.elements[j], h.elements[i]
+}
+
+// TestHeapDrainInto() verifies that DrainInto() appends the elements in
+// extraction order, reuses the buffer capacity and empties the heap.
+func TestHeapDrainInto(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	buf := make([]int, 0, 16)
+	for round := range 2 {
+		for _, v := range []int{44, 29, 58, 2, 98, 11} {
+			m.Insert(v + round)
+		}
+		drained, err := m.DrainInto(buf[:0])
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2 + round, 11 + round, 29 + round, 44 + round, 58 + round, 98 + round}, drained)
+		assert.Same(t, &buf[:1][0], &drained[0])
+		assert.Equal(t, 0, m.Size())
+	}
+	drained, err := m.DrainInto([]int{7})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7}, drained)
+}
+
+// TestHeapDrainIntoDoesNotAllocate() verifies that refilling and draining a heap
+// into a reused buffer does not allocate once the heap and the buffer have grown.
+func TestHeapDrainIntoDoesNotAllocate(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	buf := make([]int, 0, 4096)
+	cycle := func() {
+		for i := range 4096 {
+			m.Insert(4095 - i)
+		}
+		buf, _ = m.DrainInto(buf[:0])
+	}
+	cycle()
+	assert.Zero(t, testing.AllocsPerRun(10, cycle))
+	assert.Len(t, buf, 4096)
+	assert.Equal(t, 0, m.Size())
+}
+
+// TestHeapErrEmptyHeap() verifies that Remove(), Peek() and Replace() on an empty
+// heap return an error matching ErrEmptyHeap.
+func TestHeapErrEmptyHeap(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	_, err := m.Remove()
+	assert.ErrorIs(t, err, ErrEmptyHeap)
+	_, err = m.Peek()
+	assert.ErrorIs(t, err, ErrEmptyHeap)
+	_, err = m.Replace(1)
+	assert.ErrorIs(t, err, ErrEmptyHeap)
+	assert.EqualError(t, err, "empty heap")
+}
+
+// TestHeapSentinelErrors() verifies that invalid indices and positions return
+// errors matching the exported sentinels.
+func TestHeapSentinelErrors(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	m.Insert(1)
+	_, err := m.RemoveAt(5)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = m.TopK(-1)
+	assert.ErrorIs(t, err, ErrNegativeK)
+	_, err = m.KthElement(2)
+	assert.ErrorIs(t, err, ErrKOutOfRange)
+}
+
+// TestHeapSortedView() verifies that SortedView() matches repeated calls to
+// Remove() on a copy and leaves the heap untouched.
+func TestHeapSortedView(t *testing.T) {
+	m := NewMaxHeap(intComparator)
+	reference := NewMaxHeap(intComparator)
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3} {
+		m.Insert(v)
+		reference.Insert(v)
+	}
+	before := append([]int(nil), m.Elements()...)
+	view := m.SortedView()
+	var removed []int
+	for reference.Size() > 0 {
+		v, _ := reference.Remove()
+		removed = append(removed, v)
+	}
+	assert.Equal(t, removed, view)
+	assert.Equal(t, before, m.Elements())
+	assert.Empty(t, NewMinHeap(intComparator).SortedView())
+}
+
+// TestHeapPushPopAliases() verifies that Push() and Pop() behave exactly like
+// Insert() and Remove().
+func TestHeapPushPopAliases(t *testing.T) {
+	aliased := NewMinHeap(intComparator)
+	original := NewMinHeap(intComparator)
+	for _, v := range []int{5, 3, 8, 1, 9, 2} {
+		aliased.Push(v)
+		original.Insert(v)
+	}
+	assert.Equal(t, original.Elements(), aliased.Elements())
+	for original.Size() > 0 {
+		expected, err := original.Remove()
+		assert.NoError(t, err)
+		actual, err := aliased.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+	_, err := aliased.Pop()
+	assert.ErrorIs(t, err, ErrEmptyHeap)
+}
+
+// TestHeapElementsIsCopy() verifies that Elements() keeps the internal order but
+// returns a copy, so mutating it does not corrupt the heap.
+func TestHeapElementsIsCopy(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	for _, v := range []int{5, 3, 8, 1, 9} {
+		m.Insert(v)
+	}
+	elements := m.Elements()
+	assert.Equal(t, m.elements, elements)
+	elements[0] = 100
+	elements[len(elements)-1] = -1
+	root, err := m.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, root)
+	assert.True(t, m.IsValid())
+	assert.NotEqual(t, elements, m.Elements())
+}
+
+// TestHeapRemoveShrinksCapacity() verifies that draining most of a large heap
+// reduces the capacity of its internal slice while removals keep returning the
+// elements in order.
+func TestHeapRemoveShrinksCapacity(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	for i := range 1024 {
+		m.Insert(1023 - i)
+	}
+	grown := cap(m.elements)
+	for i := range 1000 {
+		v, err := m.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, i, v)
+	}
+	assert.Less(t, cap(m.elements), grown/4)
+	assert.Equal(t, 24, m.Size())
+	assertHeapProperty(t, m)
+	for m.Size() > 0 {
+		m.Remove()
+	}
+	assert.NotNil(t, m.elements)
+	assert.LessOrEqual(t, cap(m.elements), minShrinkCapacity)
+}
+
+// TestHeapSetIndexHook() verifies that the index hook keeps an external record of
+// every element's position in sync with the internal slice across the operations
+// that move elements, and that non-destructive queries do not trigger it.
+func TestHeapSetIndexHook(t *testing.T) {
+	m := NewMinHeap(intComparator)
+	positions := make(map[int]int)
+	m.SetIndexHook(func(element int, index int) { positions[element] = index })
+	assertPositions := func() {
+		assert.Len(t, positions, m.Size())
+		for i, element := range m.elements {
+			assert.Equal(t, i, positions[element], "element %d", element)
+		}
+	}
+	for _, v := range []int{50, 30, 70, 10, 40, 20, 60} {
+		m.Insert(v)
+	}
+	assertPositions()
+	removed, _ := m.Remove()
+	delete(positions, removed)
+	assertPositions()
+	removed, _ = m.RemoveAt(2)
+	delete(positions, removed)
+	assertPositions()
+	removed, _ = m.Replace(5)
+	delete(positions, removed)
+	assertPositions()
+	m.InsertAll([]int{1, 2, 3, 4, 6, 7, 8, 9})
+	assertPositions()
+	m.SetComparator(func(a, b int) int { return b - a })
+	assertPositions()
+	calls := 0
+	m.SetIndexHook(func(element int, index int) { calls++ })
+	m.TopK(3)
+	m.SortedView()
+	m.KthElement(2)
+	assert.Equal(t, 0, calls)
+	m.SetIndexHook(nil)
+	assert.NotPanics(t, func() { m.Insert(100) })
+}
+
+// TestHeapFix() verifies that Fix() restores the heap property after the ordering
+// key of an element is changed in place, whether it must move up or down, and
+// that an out-of-range index returns the same error as RemoveAt().
+func TestHeapFix(t *testing.T) {
+	byAge := func(a, b *Person) int { return a.age - b.age }
+	m := NewMinHeap(byAge)
+	people := []*Person{{"Ana", 30}, {"Leo", 55}, {"Fede", 20}, {"Lucas", 38}, {"Juan", 42}, {"Sofi", 25}}
+	for _, p := range people {
+		m.Insert(p)
+	}
+	last := m.Size() - 1
+	m.elements[last].age = 1
+	assert.False(t, m.IsValid())
+	assert.NoError(t, m.Fix(last))
+	assert.True(t, m.IsValid())
+	root, _ := m.Peek()
+	assert.Equal(t, 1, root.age)
+	m.elements[0].age = 100
+	assert.NoError(t, m.Fix(0))
+	assert.True(t, m.IsValid())
+	root, _ = m.Peek()
+	assert.Equal(t, "Fede", root.name)
+	assert.NoError(t, m.Fix(2))
+	assert.True(t, m.IsValid())
+	before := m.Elements()
+	assert.ErrorIs(t, m.Fix(-1), ErrIndexOutOfRange)
+	assert.ErrorIs(t, m.Fix(m.Size()), ErrIndexOutOfRange)
+	assert.Equal(t, before, m.Elements())
+	_, err := m.RemoveAt(m.Size())
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+// TestHeapSortedIterator() verifies that SortedIterator() yields the elements in
+// extraction order on demand, reports exhaustion, and leaves the heap untouched.
+func TestHeapSortedIterator(t *testing.T) {
+	m := NewMaxHeap(intComparator)
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3} {
+		m.Insert(v)
+	}
+	before := m.Elements()
+	next := m.SortedIterator()
+	var pulled []int
+	for range 3 {
+		v, ok := next()
+		assert.True(t, ok)
+		pulled = append(pulled, v)
+	}
+	assert.Equal(t, []int{98, 65, 58}, pulled)
+	assert.Equal(t, before, m.Elements())
+	m.Insert(100)
+	v, ok := next()
+	assert.True(t, ok)
+	assert.Equal(t, 44, v)
+	empty := NewMinHeap(intComparator).SortedIterator()
+	_, ok = empty()
+	assert.False(t, ok)
+	_, ok = empty()
+	assert.False(t, ok)
+}
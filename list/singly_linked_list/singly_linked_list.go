@@ -18,6 +18,27 @@
 //   - Iterate over the list and apply a function to each element.
 //   - Insert elements at a specified index.
 //   - Remove all occurrences of a value from the list.
+//   - Insert elements in sorted order according to a comparator.
+//   - Find the middle node of the list.
+//   - Swap the elements at two positions.
+//   - Rotate the list by a number of positions.
+//   - Partition the list into two new lists by a predicate.
+//   - Count the elements that satisfy a predicate.
+//   - Reduce the list to a single accumulated value.
+//   - Get the first and last values without dereferencing nodes.
+//   - Remove a contiguous range of elements.
+//   - Transform every value in place.
+//   - Iterate over the list with the index of each element.
+//   - Iterate over the list with a pull-style iterator.
+//   - Iterate over the list with early termination.
+//   - Remove the first or last element matching a predicate.
+//   - Get a reversed copy of the list without modifying it.
+//   - Append several elements at once.
+//   - Copy a contiguous range of elements into a new list.
+//   - Detect and break a cycle that makes the list loop forever.
+//   - Move an element to the front of the list.
+//   - Count the occurrences of a value.
+//   - Count the occurrences of every value into a dictionary.
 //
 // Most methods handle cases where the list is empty and return nil or no-op
 // accordingly. Methods like 'InsertAt()' and 'RemoveAll()' ensure safe list
@@ -28,8 +49,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/trigologiaa/go/dictionary"
 )
 
+// ErrIndexOutOfBounds is returned when an operation is attempted on a position or
+// range outside the list.
+var ErrIndexOutOfBounds = errors.New("index out of bounds")
+
 // SinglyLinkedList[T comparable] represents a singly linked list that stores
 // values of a generic type T. It maintains pointers to the head and tail nodes, as
 // well as the current size of the list.
@@ -64,6 +91,32 @@ func (l *SinglyLinkedList[T]) Tail() *SinglyLinkedNode[T] {
 	return l.tail
 }
 
+// First() returns the value stored in the head of the list.
+//
+// Returns:
+//   - The value of the first element.
+//   - false if the list is empty, otherwise true.
+func (l *SinglyLinkedList[T]) First() (T, bool) {
+	if l.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return l.Head().Data(), true
+}
+
+// Last() returns the value stored in the tail of the list.
+//
+// Returns:
+//   - The value of the last element.
+//   - false if the list is empty, otherwise true.
+func (l *SinglyLinkedList[T]) Last() (T, bool) {
+	if l.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return l.Tail().Data(), true
+}
+
 // Size() returns the number of elements in the list.
 //
 // Returns:
@@ -118,6 +171,17 @@ func (l *SinglyLinkedList[T]) Append(data T) {
 	l.size++
 }
 
+// AppendAll() inserts the given elements at the end of the list, in argument
+// order. A slice can be passed by expanding it with the ... operator.
+//
+// Parameters:
+//   - values: A variadic list of values to insert at the end of the list.
+func (l *SinglyLinkedList[T]) AppendAll(values ...T) {
+	for _, value := range values {
+		l.Append(value)
+	}
+}
+
 // Find() searches for the first node containing the specified data.
 //
 // Parameters:
@@ -217,6 +281,53 @@ func (l *SinglyLinkedList[T]) ForEach(f func(T)) {
 	}
 }
 
+// ForEachIndexed() iterates over each element in the list and applies a given
+// function, passing the zero-based position of the element along with its value.
+//
+// Parameters:
+//   - f: A function that takes the index and the value of each element. It is
+//     applied to each element in the list in order.
+func (l *SinglyLinkedList[T]) ForEachIndexed(f func(index int, value T)) {
+	index := 0
+	for current := l.Head(); current != nil; current = current.Next() {
+		f(index, current.Data())
+		index++
+	}
+}
+
+// Iterator() returns a function that yields the values of the list one at a time,
+// from head to tail, letting the caller control when to advance or stop.
+//
+// Returns:
+//   - A function that returns the next value and true, or the zero value and
+//     false once the list is exhausted.
+func (l *SinglyLinkedList[T]) Iterator() func() (T, bool) {
+	current := l.Head()
+	return func() (T, bool) {
+		if current == nil {
+			var zero T
+			return zero, false
+		}
+		value := current.Data()
+		current = current.Next()
+		return value, true
+	}
+}
+
+// ForEachUntil() iterates over each element in the list and applies a given
+// function, stopping as soon as the function returns false.
+//
+// Parameters:
+//   - f: A function that takes a value of type T and reports whether the
+//     iteration should continue.
+func (l *SinglyLinkedList[T]) ForEachUntil(f func(T) bool) {
+	for current := l.Head(); current != nil; current = current.Next() {
+		if !f(current.Data()) {
+			return
+		}
+	}
+}
+
 // InsertAt() inserts a new element at the specified index in the list.
 //
 // Parameters:
@@ -227,7 +338,7 @@ func (l *SinglyLinkedList[T]) ForEach(f func(T)) {
 //   - An error occurs if the index is invalid, otherwise, nil.
 func (l *SinglyLinkedList[T]) InsertAt(index int, data T) error {
 	if index < 0 || index > l.Size() {
-		return errors.New("index out of bounds")
+		return ErrIndexOutOfBounds
 	}
 	if index == 0 {
 		l.Prepend(data)
@@ -295,3 +406,406 @@ func (l *SinglyLinkedList[T]) Reverse() {
 	}
 	l.head = prev
 }
+
+// Reversed() returns a new list containing the elements of the list in reverse
+// order. The original list is left unchanged.
+//
+// Returns:
+//   - A pointer to a new SinglyLinkedList with the elements reversed.
+func (l *SinglyLinkedList[T]) Reversed() *SinglyLinkedList[T] {
+	reversed := NewSinglyLinkedList[T]()
+	for current := l.Head(); current != nil; current = current.Next() {
+		reversed.Prepend(current.Data())
+	}
+	return reversed
+}
+
+// InsertSorted() inserts a new element at the position that keeps the list sorted
+// according to the given comparator. The new element is placed after any elements
+// that are equal to it. If the list is empty, the element is simply appended.
+//
+// Parameters:
+//   - data: The value to insert.
+//   - less: A function that reports whether a should be placed before b.
+func (l *SinglyLinkedList[T]) InsertSorted(data T, less func(a, b T) bool) {
+	if l.IsEmpty() || !less(data, l.Tail().Data()) {
+		l.Append(data)
+		return
+	}
+	if less(data, l.Head().Data()) {
+		l.Prepend(data)
+		return
+	}
+	prev := l.Head()
+	for !less(data, prev.Next().Data()) {
+		prev = prev.Next()
+	}
+	newNode := NewSinglyLinkedNode(data)
+	newNode.SetNext(prev.Next())
+	prev.SetNext(newNode)
+	l.size++
+}
+
+// Middle() returns the middle node of the list using the slow and fast pointer
+// technique. For lists with an even number of elements, the second of the two
+// middle nodes is returned.
+//
+// Returns:
+//   - A pointer to the middle node, or nil if the list is empty.
+func (l *SinglyLinkedList[T]) Middle() *SinglyLinkedNode[T] {
+	slow := l.Head()
+	fast := l.Head()
+	for fast != nil && fast.Next() != nil {
+		slow = slow.Next()
+		fast = fast.Next().Next()
+	}
+	return slow
+}
+
+// Swap() exchanges the data stored at the two specified positions of the list.
+// The nodes themselves are not relinked, so head and tail remain the same nodes.
+//
+// Parameters:
+//   - i: The zero-based position of the first element.
+//   - j: The zero-based position of the second element.
+//
+// Returns:
+//   - An error if either index is invalid, otherwise, nil.
+func (l *SinglyLinkedList[T]) Swap(i, j int) error {
+	if i < 0 || i >= l.Size() || j < 0 || j >= l.Size() {
+		return ErrIndexOutOfBounds
+	}
+	if i == j {
+		return nil
+	}
+	var first, second *SinglyLinkedNode[T]
+	current := l.Head()
+	for index := 0; first == nil || second == nil; index++ {
+		if index == i {
+			first = current
+		}
+		if index == j {
+			second = current
+		}
+		current = current.Next()
+	}
+	data := first.Data()
+	first.SetData(second.Data())
+	second.SetData(data)
+	return nil
+}
+
+// Rotate() moves the first k elements of the list to the end. The value of k is
+// normalized modulo the size of the list, and a negative k rotates in the opposite
+// direction, moving the last elements to the front.
+//
+// Parameters:
+//   - k: The number of positions to rotate the list by.
+func (l *SinglyLinkedList[T]) Rotate(k int) {
+	if l.Size() <= 1 {
+		return
+	}
+	k = ((k % l.Size()) + l.Size()) % l.Size()
+	if k == 0 {
+		return
+	}
+	newTail := l.Head()
+	for i := 1; i < k; i++ {
+		newTail = newTail.Next()
+	}
+	l.Tail().SetNext(l.Head())
+	l.head = newTail.Next()
+	l.tail = newTail
+	newTail.SetNext(nil)
+}
+
+// Partition() splits the list into two new lists according to a predicate,
+// preserving the relative order of the elements. The original list is not
+// modified.
+//
+// Parameters:
+//   - predicate: A function that reports whether an element belongs to the first
+//     list.
+//
+// Returns:
+//   - A new list with the elements that satisfy the predicate.
+//   - A new list with the elements that do not satisfy the predicate.
+func (l *SinglyLinkedList[T]) Partition(predicate func(T) bool) (*SinglyLinkedList[T], *SinglyLinkedList[T]) {
+	matching := NewSinglyLinkedList[T]()
+	nonMatching := NewSinglyLinkedList[T]()
+	l.ForEach(func(value T) {
+		if predicate(value) {
+			matching.Append(value)
+		} else {
+			nonMatching.Append(value)
+		}
+	})
+	return matching, nonMatching
+}
+
+// Count() returns the number of elements in the list that satisfy a predicate.
+//
+// Parameters:
+//   - predicate: A function that reports whether an element should be counted.
+//
+// Returns:
+//   - The number of matching elements, or 0 if the list is empty.
+func (l *SinglyLinkedList[T]) Count(predicate func(T) bool) int {
+	count := 0
+	l.ForEach(func(value T) {
+		if predicate(value) {
+			count++
+		}
+	})
+	return count
+}
+
+// CountOccurrences() returns the number of elements in the list equal to the
+// specified data.
+//
+// Parameters:
+//   - data: The value to count.
+//
+// Returns:
+//   - The number of occurrences of the value, or 0 if the list is empty.
+func (l *SinglyLinkedList[T]) CountOccurrences(data T) int {
+	return l.Count(func(value T) bool { return value == data })
+}
+
+// Frequencies[T comparable]() counts how many times each distinct value appears in
+// the list.
+//
+// Parameters:
+//   - l: The list whose values are to be counted.
+//
+// Returns:
+//   - A pointer to a new Dictionary mapping each value to its number of
+//     occurrences, empty if the list is empty.
+func Frequencies[T comparable](l *SinglyLinkedList[T]) *dictionary.Dictionary[T, int] {
+	frequencies := dictionary.NewDictionary[T, int]()
+	l.ForEach(func(value T) {
+		frequencies.Compute(value, func(count int, _ bool) (int, bool) { return count + 1, true })
+	})
+	return frequencies
+}
+
+// Reduce[T comparable, A any]() accumulates the elements of the list from head to
+// tail using the given function.
+//
+// Parameters:
+//   - l: The list to reduce.
+//   - initial: The initial value of the accumulator.
+//   - f: A function that combines the accumulator with the next element.
+//
+// Returns:
+//   - The accumulated result, or initial if the list is empty.
+func Reduce[T comparable, A any](l *SinglyLinkedList[T], initial A, f func(acc A, value T) A) A {
+	acc := initial
+	l.ForEach(func(value T) { acc = f(acc, value) })
+	return acc
+}
+
+// Splice() removes a contiguous range of elements from the list.
+//
+// Parameters:
+//   - start: The zero-based position of the first element to remove.
+//   - count: The number of elements to remove.
+//
+// Returns:
+//   - An error occurs if the range is invalid, otherwise, nil.
+func (l *SinglyLinkedList[T]) Splice(start, count int) error {
+	if start < 0 || count < 0 || start+count > l.Size() {
+		return ErrIndexOutOfBounds
+	}
+	if count == 0 {
+		return nil
+	}
+	var prev *SinglyLinkedNode[T]
+	current := l.Head()
+	for i := 0; i < start; i++ {
+		prev = current
+		current = current.Next()
+	}
+	for i := 0; i < count; i++ {
+		current = current.Next()
+	}
+	if prev == nil {
+		l.head = current
+	} else {
+		prev.SetNext(current)
+	}
+	if current == nil {
+		l.tail = prev
+	}
+	l.size -= count
+	return nil
+}
+
+// Slice() returns a new list containing the elements in the range [start, end).
+// The new list has its own nodes, so the original list is left unchanged.
+//
+// Parameters:
+//   - start: The zero-based position of the first element to include.
+//   - end: The zero-based position after the last element to include.
+//
+// Returns:
+//   - A pointer to a new SinglyLinkedList with the elements in the range.
+//   - An error if the range is invalid.
+func (l *SinglyLinkedList[T]) Slice(start, end int) (*SinglyLinkedList[T], error) {
+	if start < 0 || end < start || end > l.Size() {
+		return nil, ErrIndexOutOfBounds
+	}
+	sublist := NewSinglyLinkedList[T]()
+	current := l.Head()
+	for i := 0; i < end; i++ {
+		if i >= start {
+			sublist.Append(current.Data())
+		}
+		current = current.Next()
+	}
+	return sublist, nil
+}
+
+// Transform() replaces the value of each element in the list with the result of
+// applying the given function to it. The nodes and the length of the list are
+// preserved.
+//
+// Parameters:
+//   - f: A function that takes a value of type T and returns its replacement.
+func (l *SinglyLinkedList[T]) Transform(f func(T) T) {
+	for current := l.Head(); current != nil; current = current.Next() {
+		current.SetData(f(current.Data()))
+	}
+}
+
+// RemoveFirstWhere() removes the first element in the list that satisfies the
+// given predicate.
+//
+// Parameters:
+//   - predicate: A function that reports whether an element should be removed.
+//
+// Returns:
+//   - true if an element was removed.
+//   - false if no element satisfied the predicate.
+func (l *SinglyLinkedList[T]) RemoveFirstWhere(predicate func(T) bool) bool {
+	var prev *SinglyLinkedNode[T]
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Data()) {
+			l.unlink(prev, current)
+			return true
+		}
+		prev = current
+	}
+	return false
+}
+
+// RemoveLastWhere() removes the last element in the list that satisfies the given
+// predicate. Since the list is singly linked, the whole list is traversed while
+// tracking the last match.
+//
+// Parameters:
+//   - predicate: A function that reports whether an element should be removed.
+//
+// Returns:
+//   - true if an element was removed.
+//   - false if no element satisfied the predicate.
+func (l *SinglyLinkedList[T]) RemoveLastWhere(predicate func(T) bool) bool {
+	var prev, matchPrev, match *SinglyLinkedNode[T]
+	for current := l.Head(); current != nil; current = current.Next() {
+		if predicate(current.Data()) {
+			matchPrev = prev
+			match = current
+		}
+		prev = current
+	}
+	if match == nil {
+		return false
+	}
+	l.unlink(matchPrev, match)
+	return true
+}
+
+// BreakCycle() repairs a list in which a node was linked back to an earlier node,
+// which would make any traversal loop forever. Floyd's algorithm is used to find
+// the node where the cycle starts, and the last node of the cycle is unlinked from
+// it, making the list linear again. The tail and size are updated to match the
+// repaired list.
+//
+// Returns:
+//   - true if a cycle was found and broken.
+//   - false if the list had no cycle.
+func (l *SinglyLinkedList[T]) BreakCycle() bool {
+	slow, fast := l.Head(), l.Head()
+	for fast != nil && fast.Next() != nil {
+		slow = slow.Next()
+		fast = fast.Next().Next()
+		if slow == fast {
+			break
+		}
+	}
+	if fast == nil || fast.Next() == nil {
+		return false
+	}
+	start := l.Head()
+	for start != slow {
+		start = start.Next()
+		slow = slow.Next()
+	}
+	last := start
+	for last.Next() != start {
+		last = last.Next()
+	}
+	last.SetNext(nil)
+	l.tail = last
+	l.size = 0
+	for current := l.Head(); current != nil; current = current.Next() {
+		l.size++
+	}
+	return true
+}
+
+// MoveToFront() moves the first node containing the specified data to the front
+// of the list, reusing the node. If it is already the head, the list is left
+// unchanged.
+//
+// Parameters:
+//   - data: The value to search for.
+//
+// Returns:
+//   - true if a node containing the data was found.
+//   - false if no node contains the data.
+func (l *SinglyLinkedList[T]) MoveToFront(data T) bool {
+	var prev *SinglyLinkedNode[T]
+	for current := l.Head(); current != nil; current = current.Next() {
+		if current.Data() == data {
+			if prev != nil {
+				l.unlink(prev, current)
+				current.SetNext(l.Head())
+				l.head = current
+				l.size++
+			}
+			return true
+		}
+		prev = current
+	}
+	return false
+}
+
+// unlink() removes a node from the list given the node that precedes it, updating
+// the head, tail, and size as needed.
+//
+// Parameters:
+//   - prev: The node preceding the one to remove, or nil if it is the head.
+//   - node: The node to remove.
+func (l *SinglyLinkedList[T]) unlink(prev, node *SinglyLinkedNode[T]) {
+	if prev == nil {
+		l.head = node.Next()
+	} else {
+		prev.SetNext(node.Next())
+	}
+	if node == l.Tail() {
+		l.tail = prev
+	}
+	node.SetNext(nil)
+	l.size--
+}
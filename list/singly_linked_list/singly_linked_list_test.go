@@ -25,6 +25,7 @@
 package singlylinkedlist
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -310,3 +311,426 @@ func TestLinkedListForEach(t *testing.T) {
 	list.ForEach(func(value int) { result = append(result, value) })
 	assert.Equal(t, []int{1, 2, 3}, result)
 }
+
+func TestLinkedListInsertSorted(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	less := func(a, b int) bool { return a < b }
+	for _, value := range []int{5, 1, 4, 2, 3, 6, 0} {
+		list.InsertSorted(value, less)
+	}
+	assert.Equal(t, 7, list.Size())
+	assert.Equal(t, 0, list.Head().Data())
+	assert.Equal(t, 6, list.Tail().Data())
+	assert.Equal(t, "SinglyLinkedList: [0] → [1] → [2] → [3] → [4] → [5] → [6]", list.String())
+}
+
+func TestLinkedListInsertSortedOnEmptyList(t *testing.T) {
+	list := NewSinglyLinkedList[string]()
+	list.InsertSorted("a", func(a, b string) bool { return a < b })
+	assert.Equal(t, 1, list.Size())
+	assert.Equal(t, "a", list.Head().Data())
+	assert.Equal(t, "a", list.Tail().Data())
+}
+
+func TestLinkedListMiddle(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	assert.Nil(t, list.Middle())
+	list.Append(1)
+	assert.Equal(t, 1, list.Middle().Data())
+	list.Append(2)
+	list.Append(3)
+	assert.Equal(t, 2, list.Middle().Data())
+	list.Append(4)
+	assert.Equal(t, 3, list.Middle().Data())
+}
+
+func TestLinkedListSwap(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+	assert.NoError(t, list.Swap(0, 4))
+	assert.Equal(t, 5, list.Head().Data())
+	assert.Equal(t, 1, list.Tail().Data())
+	assert.NoError(t, list.Swap(3, 1))
+	assert.Equal(t, "SinglyLinkedList: [5] → [4] → [3] → [2] → [1]", list.String())
+	assert.NoError(t, list.Swap(2, 2))
+	assert.Equal(t, "SinglyLinkedList: [5] → [4] → [3] → [2] → [1]", list.String())
+}
+
+func TestLinkedListSwapOutOfBounds(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	assert.EqualError(t, list.Swap(0, 2), "index out of bounds")
+	assert.EqualError(t, list.Swap(-1, 1), "index out of bounds")
+	assert.Equal(t, "SinglyLinkedList: [1] → [2]", list.String())
+}
+
+func TestLinkedListRotate(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+	list.Rotate(2)
+	assert.Equal(t, "SinglyLinkedList: [3] → [4] → [5] → [1] → [2]", list.String())
+	assert.Equal(t, 2, list.Tail().Data())
+	list.Rotate(8)
+	assert.Equal(t, "SinglyLinkedList: [1] → [2] → [3] → [4] → [5]", list.String())
+	list.Rotate(-1)
+	assert.Equal(t, "SinglyLinkedList: [5] → [1] → [2] → [3] → [4]", list.String())
+	assert.Equal(t, 4, list.Tail().Data())
+	list.Rotate(10)
+	assert.Equal(t, "SinglyLinkedList: [5] → [1] → [2] → [3] → [4]", list.String())
+	assert.Equal(t, 5, list.Size())
+}
+
+func TestLinkedListRotateEmptyAndSingle(t *testing.T) {
+	empty := NewSinglyLinkedList[int]()
+	empty.Rotate(3)
+	assert.Nil(t, empty.Head())
+	single := NewSinglyLinkedList[int]()
+	single.Append(1)
+	single.Rotate(-2)
+	assert.Equal(t, 1, single.Head().Data())
+	assert.Equal(t, 1, single.Tail().Data())
+}
+
+func TestLinkedListPartition(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for i := 1; i <= 6; i++ {
+		list.Append(i)
+	}
+	even, odd := list.Partition(func(value int) bool { return value%2 == 0 })
+	assert.Equal(t, "SinglyLinkedList: [2] → [4] → [6]", even.String())
+	assert.Equal(t, 6, even.Tail().Data())
+	assert.Equal(t, "SinglyLinkedList: [1] → [3] → [5]", odd.String())
+	assert.Equal(t, 5, odd.Tail().Data())
+	assert.Equal(t, 6, list.Size())
+	assert.Equal(t, "SinglyLinkedList: [1] → [2] → [3] → [4] → [5] → [6]", list.String())
+}
+
+func TestLinkedListPartitionEmpty(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	matching, nonMatching := list.Partition(func(value int) bool { return true })
+	assert.True(t, matching.IsEmpty())
+	assert.True(t, nonMatching.IsEmpty())
+}
+
+func TestLinkedListCount(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	assert.Equal(t, 0, list.Count(func(value int) bool { return true }))
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+	assert.Equal(t, 5, list.Count(func(value int) bool { return value > 0 }))
+	assert.Equal(t, 0, list.Count(func(value int) bool { return value > 5 }))
+	assert.Equal(t, 2, list.Count(func(value int) bool { return value%2 == 0 }))
+}
+
+func TestLinkedListReduce(t *testing.T) {
+	numbers := NewSinglyLinkedList[int]()
+	for i := 1; i <= 4; i++ {
+		numbers.Append(i)
+	}
+	sum := Reduce(numbers, 0, func(acc int, value int) int { return acc + value })
+	assert.Equal(t, 10, sum)
+	words := NewSinglyLinkedList[string]()
+	words.Append("a")
+	words.Append("b")
+	words.Append("c")
+	joined := Reduce(words, ">", func(acc string, value string) string { return acc + value })
+	assert.Equal(t, ">abc", joined)
+	empty := NewSinglyLinkedList[int]()
+	assert.Equal(t, 7, Reduce(empty, 7, func(acc int, value int) int { return acc + value }))
+}
+
+func TestLinkedListFirstAndLast(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	_, ok := list.First()
+	assert.False(t, ok)
+	_, ok = list.Last()
+	assert.False(t, ok)
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+	first, ok := list.First()
+	assert.True(t, ok)
+	assert.Equal(t, 1, first)
+	last, ok := list.Last()
+	assert.True(t, ok)
+	assert.Equal(t, 3, last)
+}
+
+func TestLinkedListSplice(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for i := 1; i <= 6; i++ {
+		list.Append(i)
+	}
+	assert.NoError(t, list.Splice(1, 2))
+	assert.Equal(t, 4, list.Size())
+	assert.Equal(t, "SinglyLinkedList: [1] → [4] → [5] → [6]", list.String())
+	assert.Equal(t, 6, list.Tail().Data())
+	assert.NoError(t, list.Splice(2, 2))
+	assert.Equal(t, 2, list.Size())
+	assert.Equal(t, "SinglyLinkedList: [1] → [4]", list.String())
+	assert.Equal(t, 4, list.Tail().Data())
+	list.Append(7)
+	assert.Equal(t, "SinglyLinkedList: [1] → [4] → [7]", list.String())
+	assert.NoError(t, list.Splice(0, 3))
+	assert.True(t, list.IsEmpty())
+	assert.Nil(t, list.Head())
+	assert.Nil(t, list.Tail())
+}
+
+func TestLinkedListSpliceOutOfBounds(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	assert.EqualError(t, list.Splice(1, 2), "index out of bounds")
+	assert.EqualError(t, list.Splice(-1, 1), "index out of bounds")
+	assert.EqualError(t, list.Splice(0, -1), "index out of bounds")
+	assert.NoError(t, list.Splice(2, 0))
+	assert.Equal(t, 2, list.Size())
+}
+
+func TestLinkedListTransform(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+	head := list.Head()
+	list.Transform(func(value int) int { return value * 2 })
+	assert.Equal(t, "SinglyLinkedList: [2] → [4] → [6]", list.String())
+	assert.Equal(t, 3, list.Size())
+	assert.Same(t, head, list.Head())
+	assert.Equal(t, 6, list.Tail().Data())
+}
+
+func TestLinkedListForEachIndexed(t *testing.T) {
+	list := NewSinglyLinkedList[string]()
+	list.Append("a")
+	list.Append("b")
+	list.Append("c")
+	var result []string
+	list.ForEachIndexed(func(index int, value string) {
+		result = append(result, fmt.Sprintf("%d:%s", index, value))
+	})
+	assert.Equal(t, []string{"0:a", "1:b", "2:c"}, result)
+}
+
+func TestLinkedListIterator(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+	next := list.Iterator()
+	var result []int
+	for value, ok := next(); ok; value, ok = next() {
+		result = append(result, value)
+		if len(result) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, result)
+	value, ok := next()
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+	_, ok = next()
+	assert.False(t, ok)
+}
+
+func TestLinkedListIteratorOnEmptyList(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	_, ok := list.Iterator()()
+	assert.False(t, ok)
+}
+
+func TestLinkedListForEachUntil(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+	var visited []int
+	list.ForEachUntil(func(value int) bool {
+		visited = append(visited, value)
+		return value != 3
+	})
+	assert.Equal(t, []int{1, 2, 3}, visited)
+	visited = nil
+	list.ForEachUntil(func(value int) bool {
+		visited = append(visited, value)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, visited)
+}
+
+func TestLinkedListErrIndexOutOfBounds(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	assert.ErrorIs(t, list.InsertAt(1, 1), ErrIndexOutOfBounds)
+	assert.ErrorIs(t, list.Swap(0, 0), ErrIndexOutOfBounds)
+	assert.ErrorIs(t, list.Splice(0, 1), ErrIndexOutOfBounds)
+}
+
+func TestLinkedListRemoveFirstWhere(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for _, value := range []int{2, 3, 4, 5, 6} {
+		list.Append(value)
+	}
+	isEven := func(value int) bool { return value%2 == 0 }
+	assert.True(t, list.RemoveFirstWhere(isEven))
+	assert.Equal(t, "SinglyLinkedList: [3] → [4] → [5] → [6]", list.String())
+	assert.Equal(t, 3, list.Head().Data())
+	assert.True(t, list.RemoveFirstWhere(isEven))
+	assert.Equal(t, "SinglyLinkedList: [3] → [5] → [6]", list.String())
+	assert.True(t, list.RemoveFirstWhere(func(value int) bool { return value == 6 }))
+	assert.Equal(t, 5, list.Tail().Data())
+	assert.False(t, list.RemoveFirstWhere(isEven))
+	assert.Equal(t, 2, list.Size())
+}
+
+func TestLinkedListRemoveLastWhere(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for _, value := range []int{2, 3, 4, 5, 6} {
+		list.Append(value)
+	}
+	isEven := func(value int) bool { return value%2 == 0 }
+	assert.True(t, list.RemoveLastWhere(isEven))
+	assert.Equal(t, "SinglyLinkedList: [2] → [3] → [4] → [5]", list.String())
+	assert.Equal(t, 5, list.Tail().Data())
+	assert.True(t, list.RemoveLastWhere(isEven))
+	assert.Equal(t, "SinglyLinkedList: [2] → [3] → [5]", list.String())
+	assert.True(t, list.RemoveLastWhere(isEven))
+	assert.Equal(t, "SinglyLinkedList: [3] → [5]", list.String())
+	assert.Equal(t, 3, list.Head().Data())
+	assert.False(t, list.RemoveLastWhere(isEven))
+	assert.Equal(t, 2, list.Size())
+	assert.True(t, list.RemoveLastWhere(func(value int) bool { return true }))
+	assert.True(t, list.RemoveLastWhere(func(value int) bool { return true }))
+	assert.True(t, list.IsEmpty())
+	assert.Nil(t, list.Head())
+	assert.Nil(t, list.Tail())
+}
+
+func TestLinkedListReversed(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	for _, value := range []int{1, 2, 3, 4} {
+		list.Append(value)
+	}
+	reversed := list.Reversed()
+	assert.Equal(t, "SinglyLinkedList: [4] → [3] → [2] → [1]", reversed.String())
+	assert.Equal(t, 4, reversed.Size())
+	assert.Equal(t, 1, reversed.Tail().Data())
+	assert.Equal(t, "SinglyLinkedList: [1] → [2] → [3] → [4]", list.String())
+	reversed.Append(0)
+	assert.Equal(t, 4, list.Size())
+	assert.True(t, NewSinglyLinkedList[int]().Reversed().IsEmpty())
+}
+
+func TestLinkedListAppendAll(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.AppendAll(1, 2, 3)
+	assert.Equal(t, 1, list.Head().Data())
+	assert.Equal(t, 3, list.Tail().Data())
+	assert.Equal(t, 3, list.Size())
+	list.AppendAll([]int{4, 5}...)
+	assert.Equal(t, "SinglyLinkedList: [1] → [2] → [3] → [4] → [5]", list.String())
+	assert.Equal(t, 5, list.Tail().Data())
+	assert.Equal(t, 5, list.Size())
+	list.AppendAll()
+	assert.Equal(t, 5, list.Size())
+}
+
+func TestLinkedListSlice(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.AppendAll(1, 2, 3, 4, 5)
+	full, err := list.Slice(0, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, list.String(), full.String())
+	assert.NotSame(t, list.Head(), full.Head())
+	middle, err := list.Slice(1, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "SinglyLinkedList: [2] → [3] → [4]", middle.String())
+	assert.Equal(t, 4, middle.Tail().Data())
+	assert.Equal(t, 3, middle.Size())
+	middle.Append(10)
+	assert.Equal(t, "SinglyLinkedList: [1] → [2] → [3] → [4] → [5]", list.String())
+	empty, err := list.Slice(2, 2)
+	assert.NoError(t, err)
+	assert.True(t, empty.IsEmpty())
+	_, err = list.Slice(-1, 2)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	_, err = list.Slice(3, 2)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	_, err = list.Slice(0, 6)
+	assert.EqualError(t, err, "index out of bounds")
+}
+
+func TestLinkedListBreakCycle(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.AppendAll(1, 2, 3, 4, 5)
+	assert.False(t, list.BreakCycle())
+	list.Tail().SetNext(list.Head().Next().Next())
+	assert.True(t, list.BreakCycle())
+	var visited []int
+	list.ForEach(func(value int) { visited = append(visited, value) })
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, visited)
+	assert.Equal(t, 5, list.Tail().Data())
+	assert.Equal(t, 5, list.Size())
+	assert.False(t, list.BreakCycle())
+}
+
+func TestLinkedListBreakCycleSelfLoops(t *testing.T) {
+	single := NewSinglyLinkedList[int]()
+	single.Append(1)
+	single.Head().SetNext(single.Head())
+	assert.True(t, single.BreakCycle())
+	assert.Equal(t, "SinglyLinkedList: [1]", single.String())
+	whole := NewSinglyLinkedList[int]()
+	whole.AppendAll(1, 2, 3)
+	whole.Tail().SetNext(whole.Head())
+	assert.True(t, whole.BreakCycle())
+	assert.Equal(t, "SinglyLinkedList: [1] → [2] → [3]", whole.String())
+	assert.Equal(t, 3, whole.Tail().Data())
+	assert.False(t, NewSinglyLinkedList[int]().BreakCycle())
+}
+
+func TestLinkedListMoveToFront(t *testing.T) {
+	list := NewSinglyLinkedList[string]()
+	list.AppendAll("a", "b", "c", "d")
+	assert.True(t, list.MoveToFront("d"))
+	assert.Equal(t, "SinglyLinkedList: [d] → [a] → [b] → [c]", list.String())
+	assert.Equal(t, "c", list.Tail().Data())
+	assert.Equal(t, 4, list.Size())
+	assert.True(t, list.MoveToFront("b"))
+	assert.Equal(t, "SinglyLinkedList: [b] → [d] → [a] → [c]", list.String())
+	assert.True(t, list.MoveToFront("b"))
+	assert.Equal(t, "SinglyLinkedList: [b] → [d] → [a] → [c]", list.String())
+	assert.False(t, list.MoveToFront("z"))
+	assert.Equal(t, 4, list.Size())
+	assert.False(t, NewSinglyLinkedList[string]().MoveToFront("a"))
+}
+
+func TestLinkedListCountOccurrences(t *testing.T) {
+	list := NewSinglyLinkedList[string]()
+	assert.Equal(t, 0, list.CountOccurrences("a"))
+	list.AppendAll("a", "b", "a", "c", "a")
+	assert.Equal(t, 3, list.CountOccurrences("a"))
+	assert.Equal(t, 1, list.CountOccurrences("b"))
+	assert.Equal(t, 0, list.CountOccurrences("z"))
+	assert.Equal(t, 5, list.Size())
+}
+
+func TestLinkedListFrequencies(t *testing.T) {
+	list := NewSinglyLinkedList[string]()
+	list.AppendAll("go", "rust", "go", "zig", "go", "rust")
+	frequencies := Frequencies(list)
+	assert.Equal(t, 3, frequencies.Size())
+	for word, expected := range map[string]int{"go": 3, "rust": 2, "zig": 1} {
+		count, err := frequencies.Get(word)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, count)
+	}
+	assert.False(t, frequencies.Contains("c"))
+	assert.True(t, Frequencies(NewSinglyLinkedList[string]()).IsEmpty())
+}
@@ -29,6 +29,10 @@ import (
 	"strings"
 )
 
+// ErrIndexOutOfBounds is returned when an operation is attempted on a position
+// outside the list.
+var ErrIndexOutOfBounds = errors.New("index out of bounds")
+
 // SinglyLinkedList represents a singly linked list that stores values of any type.
 // It maintains pointers to the head and tail nodes, as well as the current size of
 // the list.
@@ -226,7 +230,7 @@ func (l *SinglyLinkedList) ForEach(f func(any)) {
 //   - An error occurs if the index is invalid, otherwise, nil.
 func (l *SinglyLinkedList) InsertAt(index int, data any) error {
 	if index < 0 || index > l.Size() {
-		return errors.New("index out of bounds")
+		return ErrIndexOutOfBounds
 	}
 	if index == 0 {
 		l.Prepend(data)
@@ -381,3 +381,12 @@ func TestLinkedListInsertAtBounds(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "End", list.Tail().Data())
 }
+
+// TestLinkedListErrIndexOutOfBounds() verifies that InsertAt() with an invalid
+// index returns an error matching ErrIndexOutOfBounds.
+func TestLinkedListErrIndexOutOfBounds(t *testing.T) {
+	list := NewSinglyLinkedList()
+	err := list.InsertAt(1, "a")
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	assert.EqualError(t, err, "index out of bounds")
+}
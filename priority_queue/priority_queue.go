@@ -13,12 +13,29 @@
 //   - Dequeue elements with the current highest priority (min or max).
 //   - Peek at the element with highest priority without removing it.
 //   - Check if the queue is empty, get its size, or clear all elements.
+//   - Get a string representation of the queued values and their priorities.
+//   - Get the elements as a slice in priority order, with or without draining the
+//     queue.
+//   - Count how many elements are queued at each priority.
+//
+// Operations on a nil priority queue do not panic: Enqueue(), Dequeue() and Peek()
+// return an error, while Size() and IsEmpty() report an empty queue.
 //
 // Internally, the priority queue uses a generic binary heap from the heap package,
 // where elements are wrapped with their priorities for comparison.
 package priorityqueue
 
-import "github.com/trigologiaa/go/heap"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/trigologiaa/go/heap"
+)
+
+// ErrNilPriorityQueue is returned when an operation is attempted on a nil priority
+// queue.
+var ErrNilPriorityQueue = errors.New("nil priority queue")
 
 type prioritized[T any] struct {
 	value    T
@@ -61,8 +78,15 @@ func NewMaxPriorityQueue[T any]() *PriorityQueue[T] {
 // Parameters:
 //   - value: The element to insert.
 //   - priority: The priority associated with the element.
-func (pq *PriorityQueue[T]) Enqueue(value T, priority int) {
+//
+// Returns:
+//   - An error if the priority queue is nil.
+func (pq *PriorityQueue[T]) Enqueue(value T, priority int) error {
+	if pq == nil {
+		return ErrNilPriorityQueue
+	}
 	pq.heap.Insert(prioritized[T]{value: value, priority: priority})
+	return nil
 }
 
 // Dequeue() removes and returns the element with the highest priority (lowest for
@@ -70,8 +94,12 @@ func (pq *PriorityQueue[T]) Enqueue(value T, priority int) {
 //
 // Returns:
 //   - The element with the highest priority.
-//   - An error if the queue is empty.
+//   - An error if the queue is empty or nil.
 func (pq *PriorityQueue[T]) Dequeue() (T, error) {
+	if pq == nil {
+		var zero T
+		return zero, ErrNilPriorityQueue
+	}
 	item, err := pq.heap.Remove()
 	if err != nil {
 		var zero T
@@ -84,8 +112,12 @@ func (pq *PriorityQueue[T]) Dequeue() (T, error) {
 //
 // Returns:
 //   - The element with the highest priority.
-//   - An error if the queue is empty.
+//   - An error if the queue is empty or nil.
 func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if pq == nil {
+		var zero T
+		return zero, ErrNilPriorityQueue
+	}
 	item, err := pq.heap.Peek()
 	if err != nil {
 		var zero T
@@ -97,7 +129,7 @@ func (pq *PriorityQueue[T]) Peek() (T, error) {
 // IsEmpty() returns true if the priority queue has no elements.
 //
 // Returns:
-//   - true if the queue is empty.
+//   - true if the queue is empty or nil.
 //   - false if the queue is not empty.
 func (pq *PriorityQueue[T]) IsEmpty() bool {
 	return pq.Size() == 0
@@ -106,12 +138,84 @@ func (pq *PriorityQueue[T]) IsEmpty() bool {
 // Size() returns the number of elements currently in the priority queue.
 //
 // Returns:
-//   - An integer representing the number of elements.
+//   - An integer representing the number of elements, or 0 if the queue is nil.
 func (pq *PriorityQueue[T]) Size() int {
+	if pq == nil {
+		return 0
+	}
 	return pq.heap.Size()
 }
 
-// Clear() removes all elements from the priority queue, resetting it to empty.
+// Clear() removes all elements from the priority queue, resetting it to empty. It
+// is a no-op on a nil priority queue.
 func (pq *PriorityQueue[T]) Clear() {
+	if pq == nil {
+		return
+	}
 	pq.heap = heap.NewGenericHeap(pq.heap.Comparator())
 }
+
+// String() returns a string representation of the priority queue listing each
+// value with its priority in internal heap order, which is useful for debugging
+// purposes.
+//
+// Returns:
+//   - A string representing the current elements in the priority queue.
+func (pq *PriorityQueue[T]) String() string {
+	if pq == nil {
+		return "PriorityQueue: []"
+	}
+	parts := make([]string, 0, pq.Size())
+	for _, item := range pq.heap.Elements() {
+		parts = append(parts, fmt.Sprintf("(%v:%d)", item.value, item.priority))
+	}
+	return "PriorityQueue: [" + strings.Join(parts, " ") + "]"
+}
+
+// ToSortedSlice() dequeues every element into a slice in priority order, leaving
+// the priority queue empty.
+//
+// Returns:
+//   - A slice with all the elements in the order they were dequeued.
+func (pq *PriorityQueue[T]) ToSortedSlice() []T {
+	values := make([]T, 0, pq.Size())
+	for !pq.IsEmpty() {
+		value, _ := pq.Dequeue()
+		values = append(values, value)
+	}
+	return values
+}
+
+// Sorted() returns every element in the order it would be dequeued, without
+// modifying the priority queue.
+//
+// Returns:
+//   - A slice with all the elements in priority order.
+func (pq *PriorityQueue[T]) Sorted() []T {
+	if pq == nil {
+		return []T{}
+	}
+	items, _ := pq.heap.TopK(pq.Size())
+	values := make([]T, 0, len(items))
+	for _, item := range items {
+		values = append(values, item.value)
+	}
+	return values
+}
+
+// PriorityCounts() reports how many elements are queued at each priority level.
+// The internal heap is scanned without modifying the queue.
+//
+// Returns:
+//   - A map from each priority to the number of elements with that priority, or
+//     an empty map if the queue is nil.
+func (pq *PriorityQueue[T]) PriorityCounts() map[int]int {
+	counts := make(map[int]int)
+	if pq == nil {
+		return counts
+	}
+	for _, item := range pq.heap.Elements() {
+		counts[item.priority]++
+	}
+	return counts
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/trigologiaa/go/heap"
 )
 
 // TestPriorityQueueNewMinPriorityQueue() verifies that a new min priority queue is
@@ -122,3 +123,104 @@ func TestPriorityQueueClear(t *testing.T) {
 	_, err := pq.Dequeue()
 	assert.Error(t, err)
 }
+
+// TestPriorityQueueString() verifies that the string representation includes the
+// "PriorityQueue:" prefix and every value with its priority.
+func TestPriorityQueueString(t *testing.T) {
+	pq := NewMinPriorityQueue[string]()
+	assert.Equal(t, "PriorityQueue: []", pq.String())
+	pq.Enqueue("medium", 5)
+	pq.Enqueue("high", 1)
+	assert.Equal(t, "PriorityQueue: [(high:1) (medium:5)]", pq.String())
+	pq.Enqueue("low", 10)
+	str := pq.String()
+	assert.Contains(t, str, "PriorityQueue: [")
+	assert.Contains(t, str, "(low:10)")
+}
+
+// TestPriorityQueueToSortedSlice() verifies that ToSortedSlice() returns elements
+// in ascending priority for a min-queue and descending for a max-queue, emptying
+// the queue.
+func TestPriorityQueueToSortedSlice(t *testing.T) {
+	minQueue := NewMinPriorityQueue[string]()
+	maxQueue := NewMaxPriorityQueue[string]()
+	for _, pq := range []*PriorityQueue[string]{minQueue, maxQueue} {
+		pq.Enqueue("medium", 5)
+		pq.Enqueue("high", 1)
+		pq.Enqueue("low", 10)
+	}
+	assert.Equal(t, []string{"high", "medium", "low"}, minQueue.ToSortedSlice())
+	assert.True(t, minQueue.IsEmpty())
+	assert.Equal(t, []string{"low", "medium", "high"}, maxQueue.ToSortedSlice())
+	assert.True(t, maxQueue.IsEmpty())
+}
+
+// TestPriorityQueueSorted() verifies that Sorted() returns elements in priority
+// order without modifying the queue.
+func TestPriorityQueueSorted(t *testing.T) {
+	pq := NewMinPriorityQueue[string]()
+	pq.Enqueue("medium", 5)
+	pq.Enqueue("high", 1)
+	pq.Enqueue("low", 10)
+	assert.Equal(t, []string{"high", "medium", "low"}, pq.Sorted())
+	assert.Equal(t, 3, pq.Size())
+	val, err := pq.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "high", val)
+	assert.Empty(t, NewMaxPriorityQueue[int]().Sorted())
+}
+
+// TestPriorityQueueNilOperations() verifies that operations on a nil priority
+// queue do not panic and report errors where applicable.
+func TestPriorityQueueNilOperations(t *testing.T) {
+	var pq *PriorityQueue[string]
+	assert.EqualError(t, pq.Enqueue("a", 1), "nil priority queue")
+	_, err := pq.Dequeue()
+	assert.EqualError(t, err, "nil priority queue")
+	_, err = pq.Peek()
+	assert.EqualError(t, err, "nil priority queue")
+	assert.Equal(t, 0, pq.Size())
+	assert.True(t, pq.IsEmpty())
+	assert.NotPanics(t, pq.Clear)
+	assert.Equal(t, "PriorityQueue: []", pq.String())
+	assert.Empty(t, pq.Sorted())
+	assert.Empty(t, pq.ToSortedSlice())
+}
+
+// TestPriorityQueueEnqueueReturnsNoError() verifies that Enqueue() succeeds on a
+// valid priority queue.
+func TestPriorityQueueEnqueueReturnsNoError(t *testing.T) {
+	pq := NewMinPriorityQueue[string]()
+	assert.NoError(t, pq.Enqueue("a", 1))
+	assert.Equal(t, 1, pq.Size())
+}
+
+// TestPriorityQueueSentinelErrors() verifies that errors returned by nil and empty
+// priority queues match the exported sentinels.
+func TestPriorityQueueSentinelErrors(t *testing.T) {
+	var nilQueue *PriorityQueue[int]
+	_, err := nilQueue.Dequeue()
+	assert.ErrorIs(t, err, ErrNilPriorityQueue)
+	_, err = NewMinPriorityQueue[int]().Dequeue()
+	assert.ErrorIs(t, err, heap.ErrEmptyHeap)
+}
+
+// TestPriorityQueuePriorityCounts() verifies that PriorityCounts() groups the
+// elements by priority without removing any of them.
+func TestPriorityQueuePriorityCounts(t *testing.T) {
+	pq := NewMaxPriorityQueue[string]()
+	pq.Enqueue("low-a", 1)
+	pq.Enqueue("high-a", 10)
+	pq.Enqueue("mid-a", 5)
+	pq.Enqueue("low-b", 1)
+	pq.Enqueue("mid-b", 5)
+	pq.Enqueue("low-c", 1)
+	assert.Equal(t, map[int]int{1: 3, 5: 2, 10: 1}, pq.PriorityCounts())
+	assert.Equal(t, 6, pq.Size())
+	top, err := pq.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "high-a", top)
+	assert.Empty(t, NewMinPriorityQueue[int]().PriorityCounts())
+	var nilQueue *PriorityQueue[int]
+	assert.Empty(t, nilQueue.PriorityCounts())
+}
@@ -13,6 +13,16 @@
 //   - Get the number of elements in the queue.
 //   - Clear all elements from the queue.
 //   - Get a string representation of the queue contents.
+//   - Iterate over the elements from front to back.
+//   - Check whether the queue contains a given value.
+//   - Compare two queues for identical contents and order.
+//   - Drain all the elements into a slice, emptying the queue.
+//   - Pre-allocate a queue when the number of elements is known in advance.
+//   - Peek at an element by its offset from the front.
+//   - Rotate elements from the front to the back for round-robin scheduling.
+//   - Transform every element into a new queue.
+//   - Dequeue a batch of elements at once.
+//   - Reverse the order of the elements in place.
 //
 // Attempting to dequeue or peek from an empty queue will return an error.
 package queue
@@ -22,6 +32,15 @@ import (
 	"fmt"
 )
 
+var (
+	// ErrEmptyQueue is returned when an element is dequeued or inspected from an
+	// empty queue.
+	ErrEmptyQueue = errors.New("empty queue")
+	// ErrIndexOutOfBounds is returned when an element is inspected at a position
+	// outside the queue.
+	ErrIndexOutOfBounds = errors.New("index out of bounds")
+)
+
 // Queue[T any] represents a generic queue data structure that can store any type
 // data (T). The queue is implemented internally as a slice of type T.
 type Queue[T any] struct {
@@ -37,6 +56,18 @@ func NewQueue[T any]() *Queue[T] {
 	return &Queue[T]{}
 }
 
+// NewQueueWithCapacity[T any]() creates and returns a new empty queue whose
+// internal slice is pre-allocated to hold the given number of elements.
+//
+// Parameters:
+//   - capacity: The number of elements the queue is expected to hold.
+//
+// Returns:
+//   - A pointer to a new empty queue.
+func NewQueueWithCapacity[T any](capacity int) *Queue[T] {
+	return &Queue[T]{data: make([]T, 0, capacity)}
+}
+
 // Enqueue() adds an element to the back of the queue. The element is appended to
 // the end of the internal slice.
 //
@@ -55,13 +86,30 @@ func (q *Queue[T]) Enqueue(data T) {
 func (q *Queue[T]) Dequeue() (T, error) {
 	if q.IsEmpty() {
 		var zero T
-		return zero, errors.New("empty queue")
+		return zero, ErrEmptyQueue
 	}
 	head := q.data[0]
 	q.data = q.data[1:]
 	return head, nil
 }
 
+// DequeueN() removes and returns up to n elements from the front of the queue, in
+// FIFO order. If the queue holds fewer than n elements, all of them are returned.
+//
+// Parameters:
+//   - n: The maximum number of elements to remove.
+//
+// Returns:
+//   - A slice with the removed elements, empty but non-nil if n is not positive or
+//     the queue is empty.
+func (q *Queue[T]) DequeueN(n int) []T {
+	n = max(0, min(n, q.Size()))
+	batch := make([]T, n)
+	copy(batch, q.data[:n])
+	q.data = q.data[n:]
+	return batch
+}
+
 // Front() returns the element at the front of the queue without removing it. If
 // the queue is empty, it returns an error and the zero value for the type T.
 //
@@ -71,12 +119,29 @@ func (q *Queue[T]) Dequeue() (T, error) {
 func (q *Queue[T]) Front() (T, error) {
 	if q.IsEmpty() {
 		var zero T
-		return zero, errors.New("empty queue")
+		return zero, ErrEmptyQueue
 	}
 	head := q.data[0]
 	return head, nil
 }
 
+// PeekAt() returns the element at the given offset from the front of the queue
+// without removing it. An index of 0 is equivalent to Front().
+//
+// Parameters:
+//   - index: The zero-based offset from the front of the queue.
+//
+// Returns:
+//   - The element of type T at the given offset.
+//   - An error if the index is out of bounds.
+func (q *Queue[T]) PeekAt(index int) (T, error) {
+	if index < 0 || index >= q.Size() {
+		var zero T
+		return zero, ErrIndexOutOfBounds
+	}
+	return q.data[index], nil
+}
+
 // IsEmpty() checks if the queue is empty.
 //
 // Returns:
@@ -108,3 +173,142 @@ func (q *Queue[T]) Clear() {
 func (q *Queue[T]) String() string {
 	return fmt.Sprintf("Queue: %v", q.data)
 }
+
+// ForEach() applies the given function to each element of the queue, from front
+// to back, without dequeuing any of them.
+//
+// Parameters:
+//   - f: A function that takes a value of type T. It is applied to each element in
+//     the queue in order.
+func (q *Queue[T]) ForEach(f func(T)) {
+	for _, value := range q.data {
+		f(value)
+	}
+}
+
+// Contains() checks whether the queue holds an element equal to the given value,
+// scanning from front to back. This operation takes O(n) time.
+//
+// Parameters:
+//   - value: The value to search for.
+//   - equal: A function that reports whether two elements are equal.
+//
+// Returns:
+//   - true if an equal element is found.
+//   - false otherwise.
+func (q *Queue[T]) Contains(value T, equal func(a, b T) bool) bool {
+	for _, element := range q.data {
+		if equal(element, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsComparable[T comparable]() checks whether the queue holds the given
+// value, comparing elements with ==. This operation takes O(n) time.
+//
+// Parameters:
+//   - q: The queue to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - true if the value is found.
+//   - false otherwise.
+func ContainsComparable[T comparable](q *Queue[T], value T) bool {
+	return q.Contains(value, func(a, b T) bool { return a == b })
+}
+
+// Equal() checks whether the queue holds the same elements as another queue, in
+// the same order.
+//
+// Parameters:
+//   - other: The queue to compare with.
+//   - equal: A function that reports whether two elements are equal.
+//
+// Returns:
+//   - true if both queues have the same size and equal elements in the same order.
+//   - false otherwise.
+func (q *Queue[T]) Equal(other *Queue[T], equal func(a, b T) bool) bool {
+	if q.Size() != other.Size() {
+		return false
+	}
+	for i := range q.data {
+		if !equal(q.data[i], other.data[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualComparable[T comparable]() checks whether two queues hold the same elements
+// in the same order, comparing elements with ==.
+//
+// Parameters:
+//   - a: The first queue to compare.
+//   - b: The second queue to compare.
+//
+// Returns:
+//   - true if both queues have the same size and equal elements in the same order.
+//   - false otherwise.
+func EqualComparable[T comparable](a, b *Queue[T]) bool {
+	return a.Equal(b, func(x, y T) bool { return x == y })
+}
+
+// Drain() removes every element from the queue and returns them in front-to-back
+// order, leaving the queue empty.
+//
+// Returns:
+//   - A slice with all the elements of the queue, empty but non-nil if the queue
+//     was empty.
+func (q *Queue[T]) Drain() []T {
+	drained := make([]T, len(q.data))
+	copy(drained, q.data)
+	q.Clear()
+	return drained
+}
+
+// Reverse() reverses the order of the elements in the queue in place, so the
+// element at the back becomes the front. An empty or single-element queue is left
+// unchanged.
+func (q *Queue[T]) Reverse() {
+	for i, j := 0, len(q.data)-1; i < j; i, j = i+1, j-1 {
+		q.data[i], q.data[j] = q.data[j], q.data[i]
+	}
+}
+
+// Rotate() moves the element at the front of the queue to the back. It is a no-op
+// on an empty queue.
+func (q *Queue[T]) Rotate() {
+	q.RotateN(1)
+}
+
+// RotateN() moves the element at the front of the queue to the back n times. The
+// value of n is normalized modulo the size of the queue, and a non-positive n is a
+// no-op.
+//
+// Parameters:
+//   - n: The number of rotations to perform.
+func (q *Queue[T]) RotateN(n int) {
+	if n <= 0 || q.IsEmpty() {
+		return
+	}
+	n %= q.Size()
+	q.data = append(q.data[n:], q.data[:n]...)
+}
+
+// Map[T, U any]() returns a new queue whose elements are the result of applying the
+// given function to each element of the queue, keeping the front-to-back order.
+// The source queue is left unchanged.
+//
+// Parameters:
+//   - q: The queue whose elements are to be transformed.
+//   - f: A function that transforms an element of type T into one of type U.
+//
+// Returns:
+//   - A pointer to a new queue with the transformed elements.
+func Map[T, U any](q *Queue[T], f func(T) U) *Queue[U] {
+	result := NewQueueWithCapacity[U](q.Size())
+	q.ForEach(func(value T) { result.Enqueue(f(value)) })
+	return result
+}
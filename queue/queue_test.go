@@ -13,11 +13,22 @@
 //   - Get the number of elements in the queue.
 //   - Clear all elements from the queue.
 //   - Get a string representation of the queue contents.
+//   - Iterate over the elements from front to back.
+//   - Check whether the queue contains a given value.
+//   - Compare two queues for identical contents and order.
+//   - Drain all the elements into a slice, emptying the queue.
+//   - Pre-allocate a queue when the number of elements is known in advance.
+//   - Peek at an element by its offset from the front.
+//   - Rotate elements from the front to the back for round-robin scheduling.
+//   - Transform every element into a new queue.
+//   - Dequeue a batch of elements at once.
+//   - Reverse the order of the elements in place.
 //
 // Attempting to dequeue or peek from an empty queue will return an error.
 package queue
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -135,3 +146,241 @@ func TestQueueOperationsCombined(t *testing.T) {
 	assert.Equal(t, 2, v)
 	assert.Equal(t, 1, q.Size())
 }
+
+// TestQueueForEach() verifies that ForEach() visits the elements from front to
+// back and does not change the size of the queue.
+func TestQueueForEach(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	var visited []int
+	q.ForEach(func(value int) { visited = append(visited, value) })
+	assert.Equal(t, []int{1, 2, 3}, visited)
+	assert.Equal(t, 3, q.Size())
+}
+
+// TestQueueContains() verifies that Contains() reports present and absent values
+// using the supplied equality function.
+func TestQueueContains(t *testing.T) {
+	type task struct {
+		id   int
+		name string
+	}
+	q := NewQueue[task]()
+	q.Enqueue(task{1, "build"})
+	q.Enqueue(task{2, "test"})
+	sameID := func(a, b task) bool { return a.id == b.id }
+	assert.True(t, q.Contains(task{id: 2}, sameID))
+	assert.False(t, q.Contains(task{id: 3}, sameID))
+}
+
+// TestQueueContainsComparable() verifies that ContainsComparable() reports present
+// and absent values using ==.
+func TestQueueContainsComparable(t *testing.T) {
+	q := NewQueue[string]()
+	q.Enqueue("a")
+	q.Enqueue("b")
+	assert.True(t, ContainsComparable(q, "b"))
+	assert.False(t, ContainsComparable(q, "c"))
+	assert.False(t, ContainsComparable(NewQueue[string](), "a"))
+}
+
+// TestQueueEqual() verifies that Equal() and EqualComparable() compare queues by
+// size, contents and order.
+func TestQueueEqual(t *testing.T) {
+	a := NewQueue[int]()
+	b := NewQueue[int]()
+	reordered := NewQueue[int]()
+	shorter := NewQueue[int]()
+	for _, v := range []int{1, 2, 3} {
+		a.Enqueue(v)
+		b.Enqueue(v)
+	}
+	for _, v := range []int{3, 2, 1} {
+		reordered.Enqueue(v)
+	}
+	shorter.Enqueue(1)
+	shorter.Enqueue(2)
+	equal := func(x, y int) bool { return x == y }
+	assert.True(t, a.Equal(b, equal))
+	assert.False(t, a.Equal(reordered, equal))
+	assert.False(t, a.Equal(shorter, equal))
+	assert.True(t, EqualComparable(a, b))
+	assert.False(t, EqualComparable(a, reordered))
+	assert.False(t, EqualComparable(a, shorter))
+}
+
+// TestQueueDrain() verifies that Drain() returns the elements in FIFO order and
+// leaves the queue empty.
+func TestQueueDrain(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	assert.Equal(t, []int{1, 2, 3}, q.Drain())
+	assert.True(t, q.IsEmpty())
+	drained := q.Drain()
+	assert.NotNil(t, drained)
+	assert.Empty(t, drained)
+}
+
+// TestNewQueueWithCapacity() verifies that a pre-allocated queue is empty and
+// behaves like a regular queue.
+func TestNewQueueWithCapacity(t *testing.T) {
+	q := NewQueueWithCapacity[int](10)
+	assert.True(t, q.IsEmpty())
+	q.Enqueue(1)
+	q.Enqueue(2)
+	v, err := q.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+}
+
+// BenchmarkQueueEnqueueDefault() measures enqueuing a known number of elements
+// into a queue created without a capacity hint.
+func BenchmarkQueueEnqueueDefault(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		q := NewQueue[int]()
+		for i := range 10000 {
+			q.Enqueue(i)
+		}
+	}
+}
+
+// BenchmarkQueueEnqueueWithCapacity() measures enqueuing a known number of
+// elements into a queue created with NewQueueWithCapacity().
+func BenchmarkQueueEnqueueWithCapacity(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		q := NewQueueWithCapacity[int](10000)
+		for i := range 10000 {
+			q.Enqueue(i)
+		}
+	}
+}
+
+// TestQueuePeekAt() verifies that PeekAt() returns elements by offset from the
+// front without dequeuing them, and an error for out-of-range indices.
+func TestQueuePeekAt(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(10)
+	q.Enqueue(20)
+	q.Enqueue(30)
+	v, err := q.PeekAt(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, v)
+	v, err = q.PeekAt(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, v)
+	_, err = q.PeekAt(3)
+	assert.EqualError(t, err, "index out of bounds")
+	_, err = q.PeekAt(-1)
+	assert.EqualError(t, err, "index out of bounds")
+	assert.Equal(t, 3, q.Size())
+}
+
+// TestQueueRotate() verifies that Rotate() and RotateN() move elements from the
+// front to the back in round-robin order.
+func TestQueueRotate(t *testing.T) {
+	q := NewQueue[int]()
+	q.Rotate()
+	assert.True(t, q.IsEmpty())
+	for i := 1; i <= 4; i++ {
+		q.Enqueue(i)
+	}
+	q.Rotate()
+	assert.Equal(t, "Queue: [2 3 4 1]", q.String())
+	q.RotateN(2)
+	assert.Equal(t, "Queue: [4 1 2 3]", q.String())
+	q.RotateN(5)
+	assert.Equal(t, "Queue: [1 2 3 4]", q.String())
+	q.RotateN(0)
+	q.RotateN(-1)
+	assert.Equal(t, "Queue: [1 2 3 4]", q.String())
+	front, err := q.Front()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, front)
+	assert.Equal(t, 4, q.Size())
+}
+
+// TestQueueErrEmptyQueue() verifies that Dequeue() and Front() on an empty queue
+// return an error matching ErrEmptyQueue.
+func TestQueueErrEmptyQueue(t *testing.T) {
+	q := NewQueue[int]()
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, ErrEmptyQueue)
+	_, err = q.Front()
+	assert.ErrorIs(t, err, ErrEmptyQueue)
+}
+
+// TestQueueSentinelErrors() verifies that PeekAt() returns an error matching
+// ErrIndexOutOfBounds.
+func TestQueueSentinelErrors(t *testing.T) {
+	q := NewQueue[int]()
+	_, err := q.PeekAt(0)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+}
+
+// TestQueueMap() verifies that Map() transforms every element into a new queue in
+// the same front-to-back order without modifying the source queue.
+func TestQueueMap(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	mapped := Map(q, func(value int) string { return "#" + strconv.Itoa(value) })
+	assert.Equal(t, []string{"#1", "#2", "#3"}, mapped.Drain())
+	assert.Equal(t, 3, q.Size())
+	front, err := q.Front()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, front)
+	assert.True(t, Map(NewQueue[int](), func(value int) string { return "" }).IsEmpty())
+}
+
+// TestQueueDequeueN() verifies that DequeueN() removes up to n elements from the
+// front in FIFO order and returns an empty slice for a non-positive n.
+func TestQueueDequeueN(t *testing.T) {
+	q := NewQueue[int]()
+	for i := 1; i <= 5; i++ {
+		q.Enqueue(i)
+	}
+	assert.Equal(t, []int{1, 2}, q.DequeueN(2))
+	assert.Equal(t, 3, q.Size())
+	empty := q.DequeueN(0)
+	assert.NotNil(t, empty)
+	assert.Empty(t, empty)
+	assert.Empty(t, q.DequeueN(-1))
+	assert.Equal(t, 3, q.Size())
+	assert.Equal(t, []int{3, 4, 5}, q.DequeueN(3))
+	assert.True(t, q.IsEmpty())
+	q.Enqueue(6)
+	q.Enqueue(7)
+	assert.Equal(t, []int{6, 7}, q.DequeueN(10))
+	assert.True(t, q.IsEmpty())
+	assert.Empty(t, q.DequeueN(1))
+}
+
+// TestQueueReverse() verifies that Reverse() makes the back element the front one,
+// and that it leaves empty and single-element queues unchanged.
+func TestQueueReverse(t *testing.T) {
+	q := NewQueue[int]()
+	q.Reverse()
+	assert.True(t, q.IsEmpty())
+	q.Enqueue(1)
+	q.Reverse()
+	front, _ := q.Front()
+	assert.Equal(t, 1, front)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	q.Reverse()
+	assert.Equal(t, []int{3, 2, 1}, q.Drain())
+	for i := 1; i <= 4; i++ {
+		q.Enqueue(i)
+	}
+	q.Dequeue()
+	q.Reverse()
+	q.Enqueue(5)
+	assert.Equal(t, []int{4, 3, 2, 5}, q.Drain())
+}
@@ -0,0 +1,134 @@
+// Package ringbuffer provides a generic fixed-capacity circular buffer implemented
+// using Go generics. It stores up to a fixed number of elements of any type (T)
+// and, once full, overwrites the oldest element on every new push.
+//
+// This package is useful for keeping the most recent N items of a stream, such as
+// event logs, metrics samples, or undo histories with a bounded size.
+//
+// Included features:
+//   - Create a ring buffer with a fixed capacity.
+//   - Push elements, overwriting the oldest one when the buffer is full.
+//   - Pop and peek at the oldest element.
+//   - Get the number of elements and the capacity of the buffer.
+//   - Check if the buffer is full.
+//   - Get a string representation of the buffer contents.
+//
+// Attempting to pop or peek from an empty ring buffer will return an error.
+package ringbuffer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEmptyBuffer is returned when an element is popped or inspected from an empty
+// ring buffer.
+var ErrEmptyBuffer = errors.New("empty buffer")
+
+// RingBuffer[T any] represents a generic circular buffer with a fixed capacity.
+// Elements are stored in a slice of fixed length, with head pointing to the
+// oldest element.
+type RingBuffer[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+// NewRingBuffer[T any]() creates and returns a new empty ring buffer with the
+// given capacity. A capacity less than 1 creates a buffer that retains nothing.
+//
+// Parameters:
+//   - capacity: The maximum number of elements the buffer can hold.
+//
+// Returns:
+//   - A pointer to a new empty ring buffer.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	return &RingBuffer[T]{data: make([]T, max(capacity, 0))}
+}
+
+// Push() adds an element to the buffer. If the buffer is full, the oldest element
+// is overwritten.
+//
+// Parameters:
+//   - data: The element to be added to the buffer.
+func (r *RingBuffer[T]) Push(data T) {
+	if r.Cap() == 0 {
+		return
+	}
+	r.data[(r.head+r.size)%r.Cap()] = data
+	if r.IsFull() {
+		r.head = (r.head + 1) % r.Cap()
+	} else {
+		r.size++
+	}
+}
+
+// Pop() removes and returns the oldest element in the buffer. If the buffer is
+// empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The oldest element of type T in the buffer.
+//   - An error if the buffer is empty.
+func (r *RingBuffer[T]) Pop() (T, error) {
+	var zero T
+	if r.size == 0 {
+		return zero, ErrEmptyBuffer
+	}
+	value := r.data[r.head]
+	r.data[r.head] = zero
+	r.head = (r.head + 1) % r.Cap()
+	r.size--
+	return value, nil
+}
+
+// Peek() returns the oldest element in the buffer without removing it. If the
+// buffer is empty, it returns an error and the zero value for the type T.
+//
+// Returns:
+//   - The oldest element of type T in the buffer.
+//   - An error if the buffer is empty.
+func (r *RingBuffer[T]) Peek() (T, error) {
+	if r.size == 0 {
+		var zero T
+		return zero, ErrEmptyBuffer
+	}
+	return r.data[r.head], nil
+}
+
+// Len() returns the number of elements currently in the buffer.
+//
+// Returns:
+//   - The number of elements in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap() returns the maximum number of elements the buffer can hold.
+//
+// Returns:
+//   - The capacity of the buffer.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.data)
+}
+
+// IsFull() checks if the buffer has reached its capacity.
+//
+// Returns:
+//   - true if the buffer is full.
+//   - false if the buffer can hold more elements.
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.size == r.Cap()
+}
+
+// String() returns a string representation of the buffer from oldest to newest
+// element, which is useful for debugging purposes.
+//
+// Returns:
+//   - A string representing the current elements in the buffer.
+func (r *RingBuffer[T]) String() string {
+	values := make([]T, 0, r.size)
+	for i := range r.size {
+		values = append(values, r.data[(r.head+i)%r.Cap()])
+	}
+	return fmt.Sprintf("RingBuffer: %v", values)
+}
@@ -0,0 +1,126 @@
+// Package ringbuffer provides a generic fixed-capacity circular buffer implemented
+// using Go generics. It stores up to a fixed number of elements of any type (T)
+// and, once full, overwrites the oldest element on every new push.
+//
+// This package is useful for keeping the most recent N items of a stream, such as
+// event logs, metrics samples, or undo histories with a bounded size.
+//
+// Included features:
+//   - Create a ring buffer with a fixed capacity.
+//   - Push elements, overwriting the oldest one when the buffer is full.
+//   - Pop and peek at the oldest element.
+//   - Get the number of elements and the capacity of the buffer.
+//   - Check if the buffer is full.
+//   - Get a string representation of the buffer contents.
+//
+// Attempting to pop or peek from an empty ring buffer will return an error.
+package ringbuffer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewRingBuffer() verifies that a newly created ring buffer is empty and has
+// the requested capacity.
+func TestNewRingBuffer(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	assert.NotNil(t, r)
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, 3, r.Cap())
+	assert.False(t, r.IsFull())
+}
+
+// TestRingBufferPushAndPop() verifies that elements are popped in FIFO order.
+func TestRingBufferPushAndPop(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	r.Push(1)
+	r.Push(2)
+	v, err := r.Pop()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	v, err = r.Pop()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 0, r.Len())
+}
+
+// TestRingBufferOverwritesOldest() verifies that pushing beyond capacity
+// overwrites the oldest elements.
+func TestRingBufferOverwritesOldest(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	for i := 1; i <= 5; i++ {
+		r.Push(i)
+	}
+	assert.True(t, r.IsFull())
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, "RingBuffer: [3 4 5]", r.String())
+	oldest, err := r.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, oldest)
+	for _, expected := range []int{3, 4, 5} {
+		v, err := r.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+	}
+}
+
+// TestRingBufferWrapAround() verifies that interleaved pushes and pops keep the
+// correct order while wrapping around the internal slice several times.
+func TestRingBufferWrapAround(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	var expected []int
+	for i := range 20 {
+		r.Push(i)
+		expected = append(expected, i)
+		if len(expected) > 3 {
+			expected = expected[1:]
+		}
+		if i%3 == 2 {
+			v, err := r.Pop()
+			assert.NoError(t, err)
+			assert.Equal(t, expected[0], v)
+			expected = expected[1:]
+		}
+		assert.Equal(t, len(expected), r.Len())
+	}
+	for _, want := range expected {
+		v, err := r.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, want, v)
+	}
+	assert.Equal(t, 0, r.Len())
+}
+
+// TestRingBufferEmptyOperations() ensures that Pop() and Peek() return an error on
+// an empty ring buffer.
+func TestRingBufferEmptyOperations(t *testing.T) {
+	r := NewRingBuffer[string](2)
+	_, err := r.Pop()
+	assert.EqualError(t, err, "empty buffer")
+	_, err = r.Peek()
+	assert.EqualError(t, err, "empty buffer")
+	assert.Equal(t, "RingBuffer: []", r.String())
+}
+
+// TestRingBufferZeroCapacity() ensures that a ring buffer without capacity never
+// retains elements.
+func TestRingBufferZeroCapacity(t *testing.T) {
+	r := NewRingBuffer[int](0)
+	r.Push(1)
+	assert.Equal(t, 0, r.Len())
+	assert.True(t, r.IsFull())
+	_, err := r.Pop()
+	assert.Error(t, err)
+}
+
+// TestRingBufferErrEmptyBuffer() verifies that Pop() and Peek() on an empty ring
+// buffer return an error matching ErrEmptyBuffer.
+func TestRingBufferErrEmptyBuffer(t *testing.T) {
+	r := NewRingBuffer[int](1)
+	_, err := r.Pop()
+	assert.ErrorIs(t, err, ErrEmptyBuffer)
+	_, err = r.Peek()
+	assert.ErrorIs(t, err, ErrEmptyBuffer)
+}
@@ -0,0 +1,274 @@
+// Package set provides a generic set data structure implemented using Go generics.
+// It allows storing and manipulating unique elements of any comparable type (T).
+//
+// This package is useful for operations requiring collections of unique items,
+// such as membership tests, unions, intersections, and set differences.
+//
+// Included features:
+//   - Create a new set with initial elements.
+//   - Add elements to the set (ensuring uniqueness).
+//   - Remove elements from the set.
+//   - Check if an element exists in the set.
+//   - Get the number of elements in the set.
+//   - Retrieve all elements as a slice.
+//   - Clear all elements from the set.
+//   - Check if the set is empty.
+//   - Perform set operations: union, intersection, difference, symmetric difference.
+//   - Compare sets for equality, subset, and superset relationships.
+//   - Get a string representation of the set contents.
+//   - Generate the power set (all subsets) of a set.
+//   - Compute the Cartesian product of two sets.
+//   - Join the elements into a delimited string.
+//   - Pre-size a set when the number of elements is known in advance.
+//   - Reduce the set to a single accumulated value.
+//   - Reset a set while reusing its allocated memory.
+//   - Subtract several sets at once.
+//   - Find the minimum and maximum elements with a comparator.
+//   - Compute the symmetric difference of several sets.
+//   - Check whether all or any of several elements are present.
+//   - Retrieve all elements as a slice sorted by a comparator.
+//   - Visit every element with a callback that can abort on error.
+//   - Add elements and report how many were new.
+//   - Freeze a set into an immutable FrozenSet.
+//   - Project the elements into a set of derived values.
+//   - Partition the elements into two sets by a predicate.
+//   - Wrap sets of strings in a TextSet that encodes as sorted comma-separated text.
+//   - Compute an order-independent hash of the elements.
+//
+// Most methods return an error if the set receiver is nil.
+package set
+
+import "fmt"
+
+// FrozenSet[T comparable] represents an immutable set of unique elements. It
+// offers the read and set-algebra operations of Set but none of the methods that
+// modify it, so it can be shared without risk of being changed.
+type FrozenSet[T comparable] struct {
+	set *Set[T]
+}
+
+// Freeze() returns an immutable snapshot of the set. The elements are copied, so
+// later changes to the original set are not reflected in the frozen one.
+//
+// Returns:
+//   - A pointer to a new FrozenSet containing the elements of the set.
+//   - An error if the set is nil.
+func (s *Set[T]) Freeze() (*FrozenSet[T], error) {
+	if s == nil {
+		return nil, ErrNilSet
+	}
+	return &FrozenSet[T]{set: s.copy()}, nil
+}
+
+// Contains() checks whether the frozen set contains the specified element.
+//
+// Parameters:
+//   - element: The element to check for existence.
+//
+// Returns:
+//   - true if the element exists in the frozen set.
+//   - false if the element does not exist in the frozen set.
+//   - An error if the frozen set is nil.
+func (f *FrozenSet[T]) Contains(element T) (bool, error) {
+	return f.unwrap().Contains(element)
+}
+
+// ContainsAll() checks whether the frozen set contains every one of the specified
+// elements.
+//
+// Parameters:
+//   - elements: A variadic list of elements to check for existence.
+//
+// Returns:
+//   - true if all the elements exist in the frozen set.
+//   - false if at least one element does not exist in the frozen set.
+//   - An error if the frozen set is nil.
+func (f *FrozenSet[T]) ContainsAll(elements ...T) (bool, error) {
+	return f.unwrap().ContainsAll(elements...)
+}
+
+// ContainsAny() checks whether the frozen set contains at least one of the
+// specified elements.
+//
+// Parameters:
+//   - elements: A variadic list of elements to check for existence.
+//
+// Returns:
+//   - true if at least one of the elements exists in the frozen set.
+//   - false if none of the elements exist in the frozen set.
+//   - An error if the frozen set is nil.
+func (f *FrozenSet[T]) ContainsAny(elements ...T) (bool, error) {
+	return f.unwrap().ContainsAny(elements...)
+}
+
+// Size() returns the number of elements in the frozen set.
+//
+// Returns:
+//   - The number of elements in the frozen set.
+//   - An error if the frozen set is nil.
+func (f *FrozenSet[T]) Size() (int, error) {
+	return f.unwrap().Size()
+}
+
+// IsEmpty() checks whether the frozen set contains no elements.
+//
+// Returns:
+//   - true if the frozen set is empty.
+//   - false if the frozen set contains elements.
+//   - An error if the frozen set is nil.
+func (f *FrozenSet[T]) IsEmpty() (bool, error) {
+	return f.unwrap().IsEmpty()
+}
+
+// Values() returns a slice containing all the elements in the frozen set.
+// Modifying the returned slice does not affect the frozen set.
+//
+// Returns:
+//   - A slice of elements in the frozen set.
+//   - An error if the frozen set is nil.
+func (f *FrozenSet[T]) Values() ([]T, error) {
+	return f.unwrap().Values()
+}
+
+// Union() returns a new frozen set that contains all elements from both frozen
+// sets.
+//
+// Parameters:
+//   - other: The frozen set to compute the union with.
+//
+// Returns:
+//   - A new frozen set containing the union of the two sets.
+//   - An error if either frozen set is nil.
+func (f *FrozenSet[T]) Union(other *FrozenSet[T]) (*FrozenSet[T], error) {
+	return freeze(f.unwrap().Union(other.unwrap()))
+}
+
+// Intersection() returns a new frozen set containing only the elements present in
+// both frozen sets.
+//
+// Parameters:
+//   - other: The frozen set to compute the intersection with.
+//
+// Returns:
+//   - A new frozen set containing the intersection of the two sets.
+//   - An error if either frozen set is nil.
+func (f *FrozenSet[T]) Intersection(other *FrozenSet[T]) (*FrozenSet[T], error) {
+	return freeze(f.unwrap().Intersection(other.unwrap()))
+}
+
+// Difference() returns a new frozen set containing the elements present in the
+// current frozen set but not in the other one.
+//
+// Parameters:
+//   - other: The frozen set to subtract.
+//
+// Returns:
+//   - A new frozen set containing the difference of the two sets.
+//   - An error if either frozen set is nil.
+func (f *FrozenSet[T]) Difference(other *FrozenSet[T]) (*FrozenSet[T], error) {
+	return freeze(f.unwrap().Difference(other.unwrap()))
+}
+
+// SymmetricDifference() returns a new frozen set containing the elements present
+// in either frozen set but not in both.
+//
+// Parameters:
+//   - other: The frozen set to compute the symmetric difference with.
+//
+// Returns:
+//   - A new frozen set containing the symmetric difference of the two sets.
+//   - An error if either frozen set is nil.
+func (f *FrozenSet[T]) SymmetricDifference(other *FrozenSet[T]) (*FrozenSet[T], error) {
+	return freeze(f.unwrap().SymmetricDifference(other.unwrap()))
+}
+
+// Equal() checks whether both frozen sets contain exactly the same elements.
+//
+// Parameters:
+//   - other: The frozen set to check equality with.
+//
+// Returns:
+//   - true if the frozen sets are equal.
+//   - false if the frozen sets are not equal.
+//   - An error if either frozen set is nil.
+func (f *FrozenSet[T]) Equal(other *FrozenSet[T]) (bool, error) {
+	return f.unwrap().Equal(other.unwrap())
+}
+
+// Subset() checks whether the current frozen set is a subset of the other one.
+//
+// Parameters:
+//   - other: The frozen set to check if the current one is a subset of.
+//
+// Returns:
+//   - true if the current frozen set is a subset of the other one.
+//   - false otherwise.
+//   - An error if either frozen set is nil.
+func (f *FrozenSet[T]) Subset(other *FrozenSet[T]) (bool, error) {
+	return f.unwrap().Subset(other.unwrap())
+}
+
+// Superset() checks whether the current frozen set is a superset of the other one.
+//
+// Parameters:
+//   - other: The frozen set to check if the current one is a superset of.
+//
+// Returns:
+//   - true if the current frozen set is a superset of the other one.
+//   - false otherwise.
+//   - An error if either frozen set is nil.
+func (f *FrozenSet[T]) Superset(other *FrozenSet[T]) (bool, error) {
+	return f.unwrap().Superset(other.unwrap())
+}
+
+// String() returns a string representation of the frozen set's contents.
+//
+// Returns:
+//   - A formatted string listing all elements in the frozen set.
+func (f *FrozenSet[T]) String() string {
+	values, _ := f.unwrap().ToSortedSlice(func(a, b T) bool {
+		return fmt.Sprintf("%v", a) < fmt.Sprintf("%v", b)
+	})
+	return fmt.Sprintf("FrozenSet: %v", values)
+}
+
+// unwrap() returns the set backing the frozen set, or nil if the frozen set is
+// nil, so that the nil checks of Set apply to it as well.
+//
+// Returns:
+//   - A pointer to the backing Set, or nil.
+func (f *FrozenSet[T]) unwrap() *Set[T] {
+	if f == nil {
+		return nil
+	}
+	return f.set
+}
+
+// copy() returns a new set containing the same elements as the current one.
+//
+// Returns:
+//   - A pointer to a new Set with the same elements.
+func (s *Set[T]) copy() *Set[T] {
+	result := NewSetWithCapacity[T](len(s.elements))
+	for k := range s.elements {
+		result.elements[k] = struct{}{}
+	}
+	return result
+}
+
+// freeze() wraps the result of a set operation into a frozen set, propagating its
+// error.
+//
+// Parameters:
+//   - s: The set resulting from the operation.
+//   - err: The error returned by the operation.
+//
+// Returns:
+//   - A new FrozenSet backed by the given set.
+//   - The error returned by the operation, if any.
+func freeze[T comparable](s *Set[T], err error) (*FrozenSet[T], error) {
+	if err != nil {
+		return nil, err
+	}
+	return &FrozenSet[T]{set: s}, nil
+}
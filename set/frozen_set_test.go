@@ -0,0 +1,130 @@
+// Package set provides a generic set data structure implemented using Go generics.
+// It allows storing and manipulating unique elements of any comparable type (T).
+//
+// This package is useful for operations requiring collections of unique items,
+// such as membership tests, unions, intersections, and set differences.
+//
+// Included features:
+//   - Create a new set with initial elements.
+//   - Add elements to the set (ensuring uniqueness).
+//   - Remove elements from the set.
+//   - Check if an element exists in the set.
+//   - Get the number of elements in the set.
+//   - Retrieve all elements as a slice.
+//   - Clear all elements from the set.
+//   - Check if the set is empty.
+//   - Perform set operations: union, intersection, difference, symmetric difference.
+//   - Compare sets for equality, subset, and superset relationships.
+//   - Get a string representation of the set contents.
+//   - Generate the power set (all subsets) of a set.
+//   - Compute the Cartesian product of two sets.
+//   - Join the elements into a delimited string.
+//   - Pre-size a set when the number of elements is known in advance.
+//   - Reduce the set to a single accumulated value.
+//   - Reset a set while reusing its allocated memory.
+//   - Subtract several sets at once.
+//   - Find the minimum and maximum elements with a comparator.
+//   - Compute the symmetric difference of several sets.
+//   - Check whether all or any of several elements are present.
+//   - Retrieve all elements as a slice sorted by a comparator.
+//   - Visit every element with a callback that can abort on error.
+//   - Add elements and report how many were new.
+//   - Freeze a set into an immutable FrozenSet.
+//   - Project the elements into a set of derived values.
+//   - Partition the elements into two sets by a predicate.
+//   - Wrap sets of strings in a TextSet that encodes as sorted comma-separated text.
+//   - Compute an order-independent hash of the elements.
+//
+// Most methods return an error if the set receiver is nil.
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// freezeSet() is a helper function that freezes a new set with the given elements,
+// failing the test if Freeze() returns an error.
+func freezeSet[T comparable](t *testing.T, elements ...T) *FrozenSet[T] {
+	f, err := NewSet(elements...).Freeze()
+	require.NoError(t, err)
+	return f
+}
+
+// TestFrozenSetReadOperations() verifies that the read operations of a frozen set
+// report the elements of the set it was frozen from.
+func TestFrozenSetReadOperations(t *testing.T) {
+	f := freezeSet(t, 1, 2, 3)
+	exists, err := f.Contains(2)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	exists, _ = f.Contains(4)
+	assert.False(t, exists)
+	all, _ := f.ContainsAll(1, 3)
+	assert.True(t, all)
+	anyFound, _ := f.ContainsAny(4, 5)
+	assert.False(t, anyFound)
+	size, err := f.Size()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, size)
+	empty, _ := f.IsEmpty()
+	assert.False(t, empty)
+	values, err := f.Values()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{1, 2, 3}, values)
+	assert.Equal(t, "FrozenSet: [1 2 3]", f.String())
+}
+
+// TestFrozenSetIsSnapshot() verifies that changes to the original set, or to the
+// values returned by the frozen set, are not reflected in the frozen set.
+func TestFrozenSetIsSnapshot(t *testing.T) {
+	s := NewSet("a", "b")
+	f, err := s.Freeze()
+	assert.NoError(t, err)
+	s.Add("c")
+	s.Remove("a")
+	values, _ := f.Values()
+	assert.ElementsMatch(t, []string{"a", "b"}, values)
+	values[0] = "x"
+	exists, _ := f.Contains("x")
+	assert.False(t, exists)
+}
+
+// TestFrozenSetAlgebra() verifies that set-algebra operations on frozen sets
+// return frozen sets with the expected elements.
+func TestFrozenSetAlgebra(t *testing.T) {
+	a := freezeSet(t, 1, 2, 3, 4)
+	b := freezeSet(t, 3, 4, 5)
+	union, err := a.Union(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "FrozenSet: [1 2 3 4 5]", union.String())
+	intersection, _ := a.Intersection(b)
+	assert.Equal(t, "FrozenSet: [3 4]", intersection.String())
+	difference, _ := a.Difference(b)
+	assert.Equal(t, "FrozenSet: [1 2]", difference.String())
+	symmetric, _ := a.SymmetricDifference(b)
+	assert.Equal(t, "FrozenSet: [1 2 5]", symmetric.String())
+	subset, _ := intersection.Subset(a)
+	assert.True(t, subset)
+	superset, _ := a.Superset(b)
+	assert.False(t, superset)
+	equal, _ := a.Equal(freezeSet(t, 4, 3, 2, 1))
+	assert.True(t, equal)
+}
+
+// TestFrozenSetNil() verifies that freezing a nil set and operating on a nil
+// frozen set return ErrNilSet.
+func TestFrozenSetNil(t *testing.T) {
+	var nilSet *Set[int]
+	f, err := nilSet.Freeze()
+	assert.ErrorIs(t, err, ErrNilSet)
+	assert.Nil(t, f)
+	var nilFrozen *FrozenSet[int]
+	_, err = nilFrozen.Size()
+	assert.ErrorIs(t, err, ErrNilSet)
+	_, err = freezeSet(t, 1).Union(nilFrozen)
+	assert.ErrorIs(t, err, ErrNilSet)
+	assert.Equal(t, "FrozenSet: []", nilFrozen.String())
+}
@@ -16,6 +16,24 @@
 //   - Perform set operations: union, intersection, difference, symmetric difference.
 //   - Compare sets for equality, subset, and superset relationships.
 //   - Get a string representation of the set contents.
+//   - Generate the power set (all subsets) of a set.
+//   - Compute the Cartesian product of two sets.
+//   - Join the elements into a delimited string.
+//   - Pre-size a set when the number of elements is known in advance.
+//   - Reduce the set to a single accumulated value.
+//   - Reset a set while reusing its allocated memory.
+//   - Subtract several sets at once.
+//   - Find the minimum and maximum elements with a comparator.
+//   - Compute the symmetric difference of several sets.
+//   - Check whether all or any of several elements are present.
+//   - Retrieve all elements as a slice sorted by a comparator.
+//   - Visit every element with a callback that can abort on error.
+//   - Add elements and report how many were new.
+//   - Freeze a set into an immutable FrozenSet.
+//   - Project the elements into a set of derived values.
+//   - Partition the elements into two sets by a predicate.
+//   - Wrap sets of strings in a TextSet that encodes as sorted comma-separated text.
+//   - Compute an order-independent hash of the elements.
 //
 // Most methods return an error if the set receiver is nil.
 package set
@@ -23,9 +41,32 @@ package set
 import (
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"math"
 	"sort"
+	"strings"
+
+	"github.com/trigologiaa/go/tuple"
+)
+
+var (
+	// ErrNilSet is returned when an operation is attempted on a nil set.
+	ErrNilSet = errors.New("nil set")
+	// ErrEmptySet is returned when an operation requires at least one element and
+	// the set is empty.
+	ErrEmptySet = errors.New("empty set")
+	// ErrPowerSetTooLarge is returned when the power set of a set with more than
+	// MaxPowerSetElements elements is requested.
+	ErrPowerSetTooLarge = errors.New("power set too large")
+	// ErrSeparatorInElement is returned when an element to be encoded as text
+	// contains the separator used between elements.
+	ErrSeparatorInElement = errors.New("element contains separator")
 )
 
+// MaxPowerSetElements is the largest number of elements a set may have for its
+// power set to be generated, which yields 2^16 subsets.
+const MaxPowerSetElements = 16
+
 // Set[T comparable] represents a generic set structure that stores unique
 // elements, where each element is comparable.
 type Set[T comparable] struct {
@@ -46,6 +87,22 @@ func NewSet[T comparable](elements ...T) *Set[T] {
 	return s
 }
 
+// NewSetWithCapacity[T comparable]() creates and returns a new set whose backing
+// map is pre-sized for the given capacity, containing the specified elements.
+// This avoids rehashing when bulk-loading a large number of elements.
+//
+// Parameters:
+//   - capacity: The number of elements the set is expected to hold.
+//   - elements: A variadic list of elements to be added to the set.
+//
+// Returns:
+//   - A pointer to the newly created Set containing the specified elements.
+func NewSetWithCapacity[T comparable](capacity int, elements ...T) *Set[T] {
+	s := &Set[T]{elements: make(map[T]struct{}, capacity)}
+	s.Add(elements...)
+	return s
+}
+
 // Contains() Checks whether the set contains the specified element.
 //
 // Parameters:
@@ -57,12 +114,57 @@ func NewSet[T comparable](elements ...T) *Set[T] {
 //   - An error if the set is nil.
 func (s *Set[T]) Contains(element T) (bool, error) {
 	if s == nil {
-		return false, errors.New("nil set")
+		return false, ErrNilSet
 	}
 	_, exists := s.elements[element]
 	return exists, nil
 }
 
+// ContainsAll() checks whether the set contains every one of the specified
+// elements. It stops at the first missing element.
+//
+// Parameters:
+//   - elements: A variadic list of elements to check for existence.
+//
+// Returns:
+//   - true if all the elements exist in the set, including when none are given.
+//   - false if at least one element does not exist in the set.
+//   - An error if the set is nil.
+func (s *Set[T]) ContainsAll(elements ...T) (bool, error) {
+	if s == nil {
+		return false, ErrNilSet
+	}
+	for _, element := range elements {
+		if _, exists := s.elements[element]; !exists {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ContainsAny() checks whether the set contains at least one of the specified
+// elements. It stops at the first element found.
+//
+// Parameters:
+//   - elements: A variadic list of elements to check for existence.
+//
+// Returns:
+//   - true if at least one of the elements exists in the set.
+//   - false if none of the elements exist in the set, including when none are
+//     given.
+//   - An error if the set is nil.
+func (s *Set[T]) ContainsAny(elements ...T) (bool, error) {
+	if s == nil {
+		return false, ErrNilSet
+	}
+	for _, element := range elements {
+		if _, exists := s.elements[element]; exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // Add() adds the specified elements to the set.
 //
 // Parameters:
@@ -72,7 +174,7 @@ func (s *Set[T]) Contains(element T) (bool, error) {
 //   - An error if the set is nil.
 func (s *Set[T]) Add(elements ...T) error {
 	if s == nil {
-		return errors.New("nil set")
+		return ErrNilSet
 	}
 	for _, element := range elements {
 		s.elements[element] = struct{}{}
@@ -80,6 +182,29 @@ func (s *Set[T]) Add(elements ...T) error {
 	return nil
 }
 
+// AddCount() adds the specified elements to the set and reports how many of them
+// were not already present.
+//
+// Parameters:
+//   - elements: A variadic list of elements to be added.
+//
+// Returns:
+//   - The number of elements that were newly inserted.
+//   - An error if the set is nil.
+func (s *Set[T]) AddCount(elements ...T) (int, error) {
+	if s == nil {
+		return 0, ErrNilSet
+	}
+	added := 0
+	for _, element := range elements {
+		if _, exists := s.elements[element]; !exists {
+			s.elements[element] = struct{}{}
+			added++
+		}
+	}
+	return added, nil
+}
+
 // Remove() removes the specified element from the set.
 //
 // Parameters:
@@ -89,7 +214,7 @@ func (s *Set[T]) Add(elements ...T) error {
 //   - An error if the set is nil.
 func (s *Set[T]) Remove(element T) error {
 	if s == nil {
-		return errors.New("nil set")
+		return ErrNilSet
 	}
 	delete(s.elements, element)
 	return nil
@@ -102,7 +227,7 @@ func (s *Set[T]) Remove(element T) error {
 //   - An error if the set is nil.
 func (s *Set[T]) Size() (int, error) {
 	if s == nil {
-		return 0, errors.New("nil set")
+		return 0, ErrNilSet
 	}
 	return len(s.elements), nil
 }
@@ -114,7 +239,7 @@ func (s *Set[T]) Size() (int, error) {
 //   - An error if the set is nil.
 func (s *Set[T]) Values() ([]T, error) {
 	if s == nil {
-		return nil, errors.New("nil set")
+		return nil, ErrNilSet
 	}
 	size, _ := s.Size()
 	values := make([]T, 0, size)
@@ -130,12 +255,27 @@ func (s *Set[T]) Values() ([]T, error) {
 //   - An error if the set is nil.
 func (s *Set[T]) Clear() error {
 	if s == nil {
-		return errors.New("nil set")
+		return ErrNilSet
 	}
 	s.elements = make(map[T]struct{})
 	return nil
 }
 
+// Reset() removes all elements from the set while keeping the memory allocated by
+// its backing map, so refilling it avoids new allocations. Unlike Clear(), the
+// memory is not released, which makes it best suited to sets that are repeatedly
+// emptied and refilled with a similar number of elements.
+//
+// Returns:
+//   - An error if the set is nil.
+func (s *Set[T]) Reset() error {
+	if s == nil {
+		return ErrNilSet
+	}
+	clear(s.elements)
+	return nil
+}
+
 // IsEmpty() checks whether the set is empty.
 //
 // Returns:
@@ -144,7 +284,7 @@ func (s *Set[T]) Clear() error {
 //   - An error if the set is nil.
 func (s *Set[T]) IsEmpty() (bool, error) {
 	if s == nil {
-		return false, errors.New("nil set")
+		return false, ErrNilSet
 	}
 	size, _ := s.Size()
 	return size == 0, nil
@@ -161,7 +301,7 @@ func (s *Set[T]) IsEmpty() (bool, error) {
 //   - An error if either set is nil.
 func (s *Set[T]) Union(other *Set[T]) (*Set[T], error) {
 	if s == nil || other == nil {
-		return nil, errors.New("nil set")
+		return nil, ErrNilSet
 	}
 	result := NewSet[T]()
 	for k := range s.elements {
@@ -184,7 +324,7 @@ func (s *Set[T]) Union(other *Set[T]) (*Set[T], error) {
 //   - An error if either set is nil.
 func (s *Set[T]) Intersection(other *Set[T]) (*Set[T], error) {
 	if s == nil || other == nil {
-		return nil, errors.New("nil set")
+		return nil, ErrNilSet
 	}
 	result := NewSet[T]()
 	for k := range s.elements {
@@ -207,7 +347,7 @@ func (s *Set[T]) Intersection(other *Set[T]) (*Set[T], error) {
 //   - An error if either set is nil.
 func (s *Set[T]) Difference(other *Set[T]) (*Set[T], error) {
 	if s == nil || other == nil {
-		return nil, errors.New("nil set")
+		return nil, ErrNilSet
 	}
 	result := NewSet[T]()
 	for k := range s.elements {
@@ -230,7 +370,7 @@ func (s *Set[T]) Difference(other *Set[T]) (*Set[T], error) {
 //   - An error if either set is nil.
 func (s *Set[T]) SymmetricDifference(other *Set[T]) (*Set[T], error) {
 	if s == nil || other == nil {
-		return nil, errors.New("nil set")
+		return nil, ErrNilSet
 	}
 	result := NewSet[T]()
 	for k := range s.elements {
@@ -259,7 +399,7 @@ func (s *Set[T]) SymmetricDifference(other *Set[T]) (*Set[T], error) {
 //   - An error if either set is nil.
 func (s *Set[T]) Equal(other *Set[T]) (bool, error) {
 	if s == nil || other == nil {
-		return false, errors.New("nil set")
+		return false, ErrNilSet
 	}
 	s1, _ := s.Size()
 	s2, _ := other.Size()
@@ -286,7 +426,7 @@ func (s *Set[T]) Equal(other *Set[T]) (bool, error) {
 //   - An error if either set is nil.
 func (s *Set[T]) Subset(other *Set[T]) (bool, error) {
 	if s == nil || other == nil {
-		return false, errors.New("nil set")
+		return false, ErrNilSet
 	}
 	for k := range s.elements {
 		exists, _ := other.Contains(k)
@@ -308,7 +448,7 @@ func (s *Set[T]) Subset(other *Set[T]) (bool, error) {
 //   - An error if either set is nil.
 func (s *Set[T]) Superset(other *Set[T]) (bool, error) {
 	if s == nil || other == nil {
-		return false, errors.New("nil set")
+		return false, ErrNilSet
 	}
 	subset, _ := other.Subset(s)
 	return subset, nil
@@ -323,3 +463,438 @@ func (s *Set[T]) String() string {
 	sort.Slice(values, func(i, j int) bool { return fmt.Sprintf("%v", values[i]) < fmt.Sprintf("%v", values[j]) })
 	return fmt.Sprintf("Set: %v", values)
 }
+
+// PowerSet() returns every subset of the set, including the empty set and the set
+// itself. Since a set of n elements has 2^n subsets, this is only practical for
+// small sets, so sets with more than MaxPowerSetElements elements are rejected.
+//
+// Returns:
+//   - A slice containing all the subsets of the set.
+//   - An error if the set is nil or has more than MaxPowerSetElements elements.
+func (s *Set[T]) PowerSet() ([]*Set[T], error) {
+	if s == nil {
+		return nil, ErrNilSet
+	}
+	if len(s.elements) > MaxPowerSetElements {
+		return nil, ErrPowerSetTooLarge
+	}
+	values, _ := s.Values()
+	subsets := make([]*Set[T], 0, 1<<len(values))
+	for mask := 0; mask < 1<<len(values); mask++ {
+		subset := NewSet[T]()
+		for i, value := range values {
+			if mask&(1<<i) != 0 {
+				subset.Add(value)
+			}
+		}
+		subsets = append(subsets, subset)
+	}
+	return subsets, nil
+}
+
+// CartesianProduct[A, B comparable]() returns every ordered pair that can be
+// formed by taking the first element from a and the second element from b. The
+// order of the resulting pairs is unspecified.
+//
+// Parameters:
+//   - a: The set providing the first element of each pair.
+//   - b: The set providing the second element of each pair.
+//
+// Returns:
+//   - A slice containing the len(a)*len(b) ordered pairs.
+//   - An error if either set is nil.
+func CartesianProduct[A, B comparable](a *Set[A], b *Set[B]) ([]tuple.Pair[A, B], error) {
+	if a == nil || b == nil {
+		return nil, ErrNilSet
+	}
+	product := make([]tuple.Pair[A, B], 0, len(a.elements)*len(b.elements))
+	for first := range a.elements {
+		for second := range b.elements {
+			product = append(product, tuple.MakePair(first, second))
+		}
+	}
+	return product, nil
+}
+
+// Join() returns the elements of the set joined by the given separator. Since sets
+// are unordered, the order of the elements in the result is unspecified.
+//
+// Parameters:
+//   - sep: The separator placed between elements.
+//   - format: A function that converts an element to a string. If nil, elements
+//     are formatted with fmt.Sprintf("%v").
+//
+// Returns:
+//   - A string with the formatted elements joined by sep.
+//   - An error if the set is nil.
+func (s *Set[T]) Join(sep string, format func(T) string) (string, error) {
+	if s == nil {
+		return "", ErrNilSet
+	}
+	if format == nil {
+		format = func(element T) string { return fmt.Sprintf("%v", element) }
+	}
+	parts := make([]string, 0, len(s.elements))
+	for k := range s.elements {
+		parts = append(parts, format(k))
+	}
+	return strings.Join(parts, sep), nil
+}
+
+// Reduce[T comparable, A any]() accumulates the elements of the set using the
+// given function. Since sets are unordered, the order in which elements are folded
+// is unspecified, so f should be commutative and associative for the result to be
+// deterministic.
+//
+// Parameters:
+//   - s: The set to reduce.
+//   - initial: The initial value of the accumulator.
+//   - f: A function that combines the accumulator with the next element.
+//
+// Returns:
+//   - The accumulated result, or initial if the set is empty.
+//   - An error if the set is nil.
+func Reduce[T comparable, A any](s *Set[T], initial A, f func(acc A, value T) A) (A, error) {
+	if s == nil {
+		return initial, ErrNilSet
+	}
+	acc := initial
+	for k := range s.elements {
+		acc = f(acc, k)
+	}
+	return acc, nil
+}
+
+// Project[T comparable, U comparable]() returns a new set with the result of
+// applying the given function to each element of the set. Elements that project
+// to the same value appear only once in the result, so it may be smaller than the
+// original set.
+//
+// Parameters:
+//   - s: The set to project.
+//   - f: A function that derives a value of type U from an element of type T.
+//
+// Returns:
+//   - A new set containing the projected values.
+//   - An error if the set is nil.
+func Project[T comparable, U comparable](s *Set[T], f func(T) U) (*Set[U], error) {
+	if s == nil {
+		return nil, ErrNilSet
+	}
+	result := NewSetWithCapacity[U](len(s.elements))
+	for k := range s.elements {
+		result.elements[f(k)] = struct{}{}
+	}
+	return result, nil
+}
+
+// Partition() splits the set into two new sets according to the given predicate.
+// Every element ends up in exactly one of them, so their union equals the original
+// set and their intersection is empty.
+//
+// Parameters:
+//   - predicate: A function that reports whether an element belongs to the first
+//     set.
+//
+// Returns:
+//   - A new set with the elements that satisfy the predicate.
+//   - A new set with the elements that do not satisfy the predicate.
+//   - An error if the set is nil.
+func (s *Set[T]) Partition(predicate func(T) bool) (*Set[T], *Set[T], error) {
+	if s == nil {
+		return nil, nil, ErrNilSet
+	}
+	matching, nonMatching := NewSet[T](), NewSet[T]()
+	for k := range s.elements {
+		if predicate(k) {
+			matching.elements[k] = struct{}{}
+		} else {
+			nonMatching.elements[k] = struct{}{}
+		}
+	}
+	return matching, nonMatching, nil
+}
+
+// DifferenceAll() returns a new set containing only the elements of the current
+// set that are not present in any of the specified sets.
+//
+// Parameters:
+//   - others: A variadic list of sets whose elements are subtracted.
+//
+// Returns:
+//   - A new set containing the difference.
+//   - An error if the current set or any of the specified sets is nil.
+func (s *Set[T]) DifferenceAll(others ...*Set[T]) (*Set[T], error) {
+	if s == nil {
+		return nil, ErrNilSet
+	}
+	for _, other := range others {
+		if other == nil {
+			return nil, ErrNilSet
+		}
+	}
+	result := NewSet[T]()
+	for k := range s.elements {
+		excluded := false
+		for _, other := range others {
+			if _, exists := other.elements[k]; exists {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			result.Add(k)
+		}
+	}
+	return result, nil
+}
+
+// Min[T comparable]() returns the smallest element of the set according to the
+// given comparator.
+//
+// Parameters:
+//   - s: The set to scan.
+//   - less: A function that reports whether a is less than b.
+//
+// Returns:
+//   - The smallest element of the set.
+//   - An error if the set is nil or empty.
+func Min[T comparable](s *Set[T], less func(a, b T) bool) (T, error) {
+	return extreme(s, less)
+}
+
+// Max[T comparable]() returns the largest element of the set according to the
+// given comparator.
+//
+// Parameters:
+//   - s: The set to scan.
+//   - less: A function that reports whether a is less than b.
+//
+// Returns:
+//   - The largest element of the set.
+//   - An error if the set is nil or empty.
+func Max[T comparable](s *Set[T], less func(a, b T) bool) (T, error) {
+	return extreme(s, func(a, b T) bool { return less(b, a) })
+}
+
+// extreme() returns the element of the set that is ordered before every other one
+// according to the given comparator.
+//
+// Parameters:
+//   - s: The set to scan.
+//   - before: A function that reports whether a is ordered before b.
+//
+// Returns:
+//   - The first element in the comparator's order.
+//   - An error if the set is nil or empty.
+func extreme[T comparable](s *Set[T], before func(a, b T) bool) (T, error) {
+	var result T
+	if s == nil {
+		return result, ErrNilSet
+	}
+	if len(s.elements) == 0 {
+		return result, ErrEmptySet
+	}
+	first := true
+	for k := range s.elements {
+		if first || before(k, result) {
+			result = k
+			first = false
+		}
+	}
+	return result, nil
+}
+
+// SymmetricDifferenceAll() returns a new set containing the elements that appear
+// in an odd number of the sets formed by the current set and the specified ones.
+// With a single argument, it is equivalent to SymmetricDifference().
+//
+// Parameters:
+//   - others: A variadic list of sets to combine with the current set.
+//
+// Returns:
+//   - A new set containing the elements present in an odd number of sets.
+//   - An error if the current set or any of the specified sets is nil.
+func (s *Set[T]) SymmetricDifferenceAll(others ...*Set[T]) (*Set[T], error) {
+	if s == nil {
+		return nil, ErrNilSet
+	}
+	for _, other := range others {
+		if other == nil {
+			return nil, ErrNilSet
+		}
+	}
+	result := NewSet[T]()
+	for _, current := range append([]*Set[T]{s}, others...) {
+		for k := range current.elements {
+			if _, exists := result.elements[k]; exists {
+				delete(result.elements, k)
+			} else {
+				result.elements[k] = struct{}{}
+			}
+		}
+	}
+	return result, nil
+}
+
+// ToSortedSlice() returns a slice containing all the elements in the set, sorted
+// according to the given comparator.
+//
+// Parameters:
+//   - less: A function that reports whether a should be placed before b.
+//
+// Returns:
+//   - A sorted slice of elements in the set.
+//   - An error if the set is nil.
+func (s *Set[T]) ToSortedSlice(less func(a, b T) bool) ([]T, error) {
+	if s == nil {
+		return nil, ErrNilSet
+	}
+	values, _ := s.Values()
+	sort.Slice(values, func(i, j int) bool { return less(values[i], values[j]) })
+	return values, nil
+}
+
+// Each() applies the given function to each element of the set, stopping at the
+// first error it returns. The order in which elements are visited is unspecified.
+//
+// Parameters:
+//   - f: A function that takes an element and returns an error to abort the
+//     iteration.
+//
+// Returns:
+//   - The first non-nil error returned by f.
+//   - An error if the set is nil.
+func (s *Set[T]) Each(f func(T) error) error {
+	if s == nil {
+		return ErrNilSet
+	}
+	for k := range s.elements {
+		if err := f(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Hash() computes a hash of the set's contents that does not depend on the order
+// in which the elements are stored, so equal sets always produce the same value.
+// Each element is hashed with FNV-1a and the results are combined with XOR.
+// Elements of the predeclared boolean, numeric and string types are hashed from
+// their value without allocating, and a floating-point negative zero hashes like
+// positive zero since both are the same element. Other types are hashed over
+// their Go-syntax representation, so a composite element holding -0.0 hashes
+// differently from one holding 0.0 even though the two compare equal. Different
+// sets may collide, and the hash is not suitable for cryptographic use.
+//
+// Returns:
+//   - The hash of the set, or 0 if the set is empty.
+//   - An error if the set is nil.
+func (s *Set[T]) Hash() (uint64, error) {
+	if s == nil {
+		return 0, ErrNilSet
+	}
+	var hash uint64
+	for k := range s.elements {
+		hash ^= hashElement(k)
+	}
+	return hash, nil
+}
+
+// FNV-1a parameters used by hashElement().
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// hashElement[T comparable]() returns the FNV-1a hash of a single element. Values
+// of the predeclared types are prefixed with a tag identifying their type, so
+// equal bit patterns of different types held in a set of interfaces hash
+// differently.
+//
+// Parameters:
+//   - element: The element to hash.
+//
+// Returns:
+//   - The hash of the element.
+func hashElement[T comparable](element T) uint64 {
+	var h uint64 = fnvOffset64
+	switch v := any(element).(type) {
+	case bool:
+		if v {
+			return fnvByte(fnvByte(h, 1), 1)
+		}
+		return fnvByte(fnvByte(h, 1), 0)
+	case int:
+		return fnvUint64(fnvByte(h, 2), uint64(v))
+	case int8:
+		return fnvUint64(fnvByte(h, 3), uint64(v))
+	case int16:
+		return fnvUint64(fnvByte(h, 4), uint64(v))
+	case int32:
+		return fnvUint64(fnvByte(h, 5), uint64(v))
+	case int64:
+		return fnvUint64(fnvByte(h, 6), uint64(v))
+	case uint:
+		return fnvUint64(fnvByte(h, 7), uint64(v))
+	case uint8:
+		return fnvUint64(fnvByte(h, 8), uint64(v))
+	case uint16:
+		return fnvUint64(fnvByte(h, 9), uint64(v))
+	case uint32:
+		return fnvUint64(fnvByte(h, 10), uint64(v))
+	case uint64:
+		return fnvUint64(fnvByte(h, 11), v)
+	case uintptr:
+		return fnvUint64(fnvByte(h, 12), uint64(v))
+	case float32:
+		if v == 0 {
+			v = 0
+		}
+		return fnvUint64(fnvByte(h, 13), uint64(math.Float32bits(v)))
+	case float64:
+		if v == 0 {
+			v = 0
+		}
+		return fnvUint64(fnvByte(h, 14), math.Float64bits(v))
+	case string:
+		h = fnvByte(h, 15)
+		for i := 0; i < len(v); i++ {
+			h = fnvByte(h, v[i])
+		}
+		return h
+	default:
+		f := fnv.New64a()
+		fmt.Fprintf(f, "%#v", element)
+		return f.Sum64()
+	}
+}
+
+// fnvByte() adds a single byte to an FNV-1a hash.
+//
+// Parameters:
+//   - h: The current hash.
+//   - b: The byte to add.
+//
+// Returns:
+//   - The updated hash.
+func fnvByte(h uint64, b byte) uint64 {
+	return (h ^ uint64(b)) * fnvPrime64
+}
+
+// fnvUint64() adds the eight bytes of a value, least significant first, to an
+// FNV-1a hash.
+//
+// Parameters:
+//   - h: The current hash.
+//   - v: The value to add.
+//
+// Returns:
+//   - The updated hash.
+func fnvUint64(h uint64, v uint64) uint64 {
+	for range 8 {
+		h = fnvByte(h, byte(v))
+		v >>= 8
+	}
+	return h
+}
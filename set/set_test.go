@@ -16,11 +16,33 @@
 //   - Perform set operations: union, intersection, difference, symmetric difference.
 //   - Compare sets for equality, subset, and superset relationships.
 //   - Get a string representation of the set contents.
+//   - Generate the power set (all subsets) of a set.
+//   - Compute the Cartesian product of two sets.
+//   - Join the elements into a delimited string.
+//   - Pre-size a set when the number of elements is known in advance.
+//   - Reduce the set to a single accumulated value.
+//   - Reset a set while reusing its allocated memory.
+//   - Subtract several sets at once.
+//   - Find the minimum and maximum elements with a comparator.
+//   - Compute the symmetric difference of several sets.
+//   - Check whether all or any of several elements are present.
+//   - Retrieve all elements as a slice sorted by a comparator.
+//   - Visit every element with a callback that can abort on error.
+//   - Add elements and report how many were new.
+//   - Freeze a set into an immutable FrozenSet.
+//   - Project the elements into a set of derived values.
+//   - Partition the elements into two sets by a predicate.
+//   - Wrap sets of strings in a TextSet that encodes as sorted comma-separated text.
+//   - Compute an order-independent hash of the elements.
 //
 // Most methods return an error if the set receiver is nil.
 package set
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -444,3 +466,510 @@ func getValues[T comparable](t *testing.T, set *Set[T]) []T {
 	assert.NoError(t, err)
 	return values
 }
+
+// TestSetPowerSet() verifies that PowerSet() returns all the subsets of a set,
+// including the empty set and the set itself.
+func TestSetPowerSet(t *testing.T) {
+	set := NewSet(1, 2, 3)
+	subsets, err := set.PowerSet()
+	assert.NoError(t, err)
+	assert.Len(t, subsets, 8)
+	expected := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+	for _, members := range expected {
+		found := false
+		for _, subset := range subsets {
+			equal, err := subset.Equal(NewSet(members...))
+			assert.NoError(t, err)
+			if equal {
+				found = true
+				break
+			}
+		}
+		assert.True(t, found, "missing subset %v", members)
+	}
+}
+
+// TestSetPowerSetOnNilSet() ensures that PowerSet() returns an error when called
+// on a nil set.
+func TestSetPowerSetOnNilSet(t *testing.T) {
+	var nilSet *Set[int]
+	_, err := nilSet.PowerSet()
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetPowerSetSizeLimit() verifies that PowerSet() succeeds on a set with
+// exactly MaxPowerSetElements elements and rejects larger ones, including sizes
+// whose subset count would overflow an int.
+func TestSetPowerSetSizeLimit(t *testing.T) {
+	s := NewSet[int]()
+	for i := range MaxPowerSetElements {
+		s.Add(i)
+	}
+	subsets, err := s.PowerSet()
+	assert.NoError(t, err)
+	assert.Len(t, subsets, 1<<MaxPowerSetElements)
+	s.Add(MaxPowerSetElements)
+	subsets, err = s.PowerSet()
+	assert.ErrorIs(t, err, ErrPowerSetTooLarge)
+	assert.Nil(t, subsets)
+	for i := range 64 {
+		s.Add(i)
+	}
+	_, err = s.PowerSet()
+	assert.ErrorIs(t, err, ErrPowerSetTooLarge)
+}
+
+// TestSetCartesianProduct() verifies that CartesianProduct() returns every
+// ordered pair exactly once.
+func TestSetCartesianProduct(t *testing.T) {
+	a := NewSet(1, 2)
+	b := NewSet("x", "y", "z")
+	product, err := CartesianProduct(a, b)
+	assert.NoError(t, err)
+	assert.Len(t, product, 6)
+	seen := make(map[string]bool)
+	for _, pair := range product {
+		key := fmt.Sprintf("%d%s", pair.First, pair.Second)
+		assert.False(t, seen[key], "duplicate pair %s", key)
+		seen[key] = true
+	}
+	for _, key := range []string{"1x", "1y", "1z", "2x", "2y", "2z"} {
+		assert.True(t, seen[key], "missing pair %s", key)
+	}
+}
+
+// TestSetCartesianProductOnNilSet() ensures that CartesianProduct() returns an
+// error when either set is nil.
+func TestSetCartesianProductOnNilSet(t *testing.T) {
+	var nilSet *Set[int]
+	_, err := CartesianProduct(nilSet, NewSet(1))
+	assert.EqualError(t, err, "nil set")
+	_, err = CartesianProduct(NewSet(1), nilSet)
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetJoin() verifies that Join() joins every element with the separator,
+// using either the supplied formatter or the default one.
+func TestSetJoin(t *testing.T) {
+	set := NewSet(1, 2, 3)
+	joined, err := set.Join(",", nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, strings.Split(joined, ","))
+	joined, err = set.Join(";", func(v int) string { return fmt.Sprintf("#%d", v) })
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"#1", "#2", "#3"}, strings.Split(joined, ";"))
+}
+
+// TestSetJoinOnEmptyAndNilSet() ensures that Join() returns an empty string for an
+// empty set and an error for a nil set.
+func TestSetJoinOnEmptyAndNilSet(t *testing.T) {
+	joined, err := NewSet[int]().Join(",", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", joined)
+	var nilSet *Set[int]
+	_, err = nilSet.Join(",", nil)
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetNewSetWithCapacity() verifies that a pre-sized set behaves like a regular
+// set.
+func TestSetNewSetWithCapacity(t *testing.T) {
+	set := NewSetWithCapacity(10, 1, 2, 2, 3)
+	size, err := set.Size()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, size)
+	exists, err := set.Contains(2)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+// BenchmarkSetAddDefault() measures bulk insertion into a set created without a
+// capacity hint.
+func BenchmarkSetAddDefault(b *testing.B) {
+	for b.Loop() {
+		set := NewSet[int]()
+		for i := range 100000 {
+			set.Add(i)
+		}
+	}
+}
+
+// BenchmarkSetAddWithCapacity() measures bulk insertion into a set pre-sized with
+// NewSetWithCapacity().
+func BenchmarkSetAddWithCapacity(b *testing.B) {
+	for b.Loop() {
+		set := NewSetWithCapacity[int](100000)
+		for i := range 100000 {
+			set.Add(i)
+		}
+	}
+}
+
+// TestSetReduce() verifies that Reduce() folds every element of the set into the
+// accumulator.
+func TestSetReduce(t *testing.T) {
+	set := NewSet(1, 2, 3, 4)
+	sum, err := Reduce(set, 0, func(acc int, value int) int { return acc + value })
+	assert.NoError(t, err)
+	assert.Equal(t, 10, sum)
+	sum, err = Reduce(NewSet[int](), 5, func(acc int, value int) int { return acc + value })
+	assert.NoError(t, err)
+	assert.Equal(t, 5, sum)
+	var nilSet *Set[int]
+	_, err = Reduce(nilSet, 0, func(acc int, value int) int { return acc + value })
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetReset() verifies that Reset() empties the set and that it can be refilled
+// afterwards.
+func TestSetReset(t *testing.T) {
+	set := NewSet(1, 2, 3)
+	err := set.Reset()
+	assert.NoError(t, err)
+	isEmpty, err := set.IsEmpty()
+	assert.NoError(t, err)
+	assert.True(t, isEmpty)
+	set.Add(4)
+	assert.ElementsMatch(t, []int{4}, getValues(t, set))
+	var nilSet *Set[int]
+	assert.EqualError(t, nilSet.Reset(), "nil set")
+}
+
+// BenchmarkSetFillAndClear() measures repeatedly filling a set and emptying it
+// with Clear().
+func BenchmarkSetFillAndClear(b *testing.B) {
+	set := NewSet[int]()
+	for b.Loop() {
+		for i := range 10000 {
+			set.Add(i)
+		}
+		set.Clear()
+	}
+}
+
+// BenchmarkSetFillAndReset() measures repeatedly filling a set and emptying it
+// with Reset().
+func BenchmarkSetFillAndReset(b *testing.B) {
+	set := NewSet[int]()
+	for b.Loop() {
+		for i := range 10000 {
+			set.Add(i)
+		}
+		set.Reset()
+	}
+}
+
+// TestSetDifferenceAll() verifies that DifferenceAll() subtracts several sets at
+// once and matches chained calls to Difference().
+func TestSetDifferenceAll(t *testing.T) {
+	a := NewSet(1, 2, 3, 4, 5, 6)
+	b := NewSet(2, 4)
+	c := NewSet(4, 5, 7)
+	result, err := a.DifferenceAll(b, c)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{1, 3, 6}, getValues(t, result))
+	chained, err := a.Difference(b)
+	assert.NoError(t, err)
+	chained, err = chained.Difference(c)
+	assert.NoError(t, err)
+	equal, err := result.Equal(chained)
+	assert.NoError(t, err)
+	assert.True(t, equal)
+	result, err = a.DifferenceAll()
+	assert.NoError(t, err)
+	equal, _ = result.Equal(a)
+	assert.True(t, equal)
+}
+
+// TestSetDifferenceAllWithNilSet() ensures that DifferenceAll() returns an error
+// when the receiver or any argument is nil.
+func TestSetDifferenceAllWithNilSet(t *testing.T) {
+	var nilSet *Set[int]
+	_, err := NewSet(1).DifferenceAll(NewSet(2), nilSet)
+	assert.EqualError(t, err, "nil set")
+	_, err = nilSet.DifferenceAll(NewSet(2))
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetMinAndMax() verifies that Min() and Max() return the extreme elements of
+// int and string sets.
+func TestSetMinAndMax(t *testing.T) {
+	numbers := NewSet(5, 3, 9, -2, 7)
+	lessInt := func(a, b int) bool { return a < b }
+	minimum, err := Min(numbers, lessInt)
+	assert.NoError(t, err)
+	assert.Equal(t, -2, minimum)
+	maximum, err := Max(numbers, lessInt)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, maximum)
+	words := NewSet("pear", "apple", "zucchini", "kiwi")
+	lessString := func(a, b string) bool { return a < b }
+	first, err := Min(words, lessString)
+	assert.NoError(t, err)
+	assert.Equal(t, "apple", first)
+	last, err := Max(words, lessString)
+	assert.NoError(t, err)
+	assert.Equal(t, "zucchini", last)
+}
+
+// TestSetMinAndMaxErrors() ensures that Min() and Max() return distinct errors for
+// nil and empty sets.
+func TestSetMinAndMaxErrors(t *testing.T) {
+	lessInt := func(a, b int) bool { return a < b }
+	var nilSet *Set[int]
+	_, err := Min(nilSet, lessInt)
+	assert.EqualError(t, err, "nil set")
+	_, err = Max(nilSet, lessInt)
+	assert.EqualError(t, err, "nil set")
+	_, err = Min(NewSet[int](), lessInt)
+	assert.EqualError(t, err, "empty set")
+	_, err = Max(NewSet[int](), lessInt)
+	assert.EqualError(t, err, "empty set")
+}
+
+// TestSetSymmetricDifferenceAll() verifies that SymmetricDifferenceAll() keeps the
+// elements that appear in an odd number of sets.
+func TestSetSymmetricDifferenceAll(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(2, 3, 5)
+	c := NewSet(3, 4, 5, 6)
+	result, err := a.SymmetricDifferenceAll(b, c)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{1, 3, 6}, getValues(t, result))
+	pairwise, err := a.SymmetricDifference(b)
+	assert.NoError(t, err)
+	result, err = a.SymmetricDifferenceAll(b)
+	assert.NoError(t, err)
+	equal, _ := result.Equal(pairwise)
+	assert.True(t, equal)
+	var nilSet *Set[int]
+	_, err = a.SymmetricDifferenceAll(b, nilSet)
+	assert.EqualError(t, err, "nil set")
+	_, err = nilSet.SymmetricDifferenceAll(a)
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetContainsAll() verifies that ContainsAll() reports whether every element
+// is present, treating an empty list as true.
+func TestSetContainsAll(t *testing.T) {
+	set := NewSet(1, 2, 3)
+	all, err := set.ContainsAll(1, 3)
+	assert.NoError(t, err)
+	assert.True(t, all)
+	all, err = set.ContainsAll(1, 4)
+	assert.NoError(t, err)
+	assert.False(t, all)
+	all, err = set.ContainsAll()
+	assert.NoError(t, err)
+	assert.True(t, all)
+	var nilSet *Set[int]
+	_, err = nilSet.ContainsAll(1)
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetContainsAny() verifies that ContainsAny() reports whether at least one
+// element is present, treating an empty list as false.
+func TestSetContainsAny(t *testing.T) {
+	set := NewSet(1, 2, 3)
+	found, err := set.ContainsAny(5, 3)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	found, err = set.ContainsAny(4, 5)
+	assert.NoError(t, err)
+	assert.False(t, found)
+	found, err = set.ContainsAny()
+	assert.NoError(t, err)
+	assert.False(t, found)
+	var nilSet *Set[int]
+	_, err = nilSet.ContainsAny(1)
+	assert.EqualError(t, err, "nil set")
+}
+
+// TestSetSentinelErrors() verifies that errors returned for nil and empty sets
+// match ErrNilSet and ErrEmptySet.
+func TestSetSentinelErrors(t *testing.T) {
+	var nilSet *Set[int]
+	_, err := nilSet.Contains(1)
+	assert.ErrorIs(t, err, ErrNilSet)
+	_, err = NewSet(1).Union(nilSet)
+	assert.ErrorIs(t, err, ErrNilSet)
+	_, err = Min(NewSet[int](), func(a, b int) bool { return a < b })
+	assert.ErrorIs(t, err, ErrEmptySet)
+}
+
+// TestSetToSortedSlice() verifies that ToSortedSlice() returns the elements in
+// ascending or descending order depending on the comparator.
+func TestSetToSortedSlice(t *testing.T) {
+	set := NewSet(5, 3, 9, 1, 7)
+	ascending, err := set.ToSortedSlice(func(a, b int) bool { return a < b })
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, ascending)
+	descending, err := set.ToSortedSlice(func(a, b int) bool { return a > b })
+	assert.NoError(t, err)
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, descending)
+	var nilSet *Set[int]
+	_, err = nilSet.ToSortedSlice(func(a, b int) bool { return a < b })
+	assert.ErrorIs(t, err, ErrNilSet)
+}
+
+// TestSetEach() verifies that Each() visits every element and stops at the first
+// error returned by the callback.
+func TestSetEach(t *testing.T) {
+	set := NewSet(1, 2, 3, 4)
+	visited := 0
+	err := set.Each(func(v int) error {
+		visited++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, visited)
+	invalid := errors.New("invalid element")
+	visited = 0
+	err = set.Each(func(v int) error {
+		visited++
+		if v == 3 {
+			return invalid
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, invalid)
+	assert.LessOrEqual(t, visited, 4)
+	var nilSet *Set[int]
+	assert.ErrorIs(t, nilSet.Each(func(v int) error { return nil }), ErrNilSet)
+}
+
+// TestSetAddCount() verifies that AddCount() inserts the elements and reports only
+// those that were not already present, counting duplicates in the input once.
+func TestSetAddCount(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	added, err := s.AddCount(2, 3, 4, 5, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, added)
+	size, _ := s.Size()
+	assert.Equal(t, 5, size)
+	added, err = s.AddCount(1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, added)
+	added, err = s.AddCount()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, added)
+	var nilSet *Set[int]
+	_, err = nilSet.AddCount(1)
+	assert.ErrorIs(t, err, ErrNilSet)
+}
+
+// TestSetProject() verifies that Project() maps every element into a new set,
+// merging the elements that project to the same value.
+func TestSetProject(t *testing.T) {
+	s := NewSet("apple", "avocado", "banana", "cherry")
+	initials, err := Project(s, func(word string) byte { return word[0] })
+	assert.NoError(t, err)
+	values, _ := initials.Values()
+	assert.ElementsMatch(t, []byte{'a', 'b', 'c'}, values)
+	lengths, err := Project(s, func(word string) int { return len(word) })
+	assert.NoError(t, err)
+	values2, _ := lengths.Values()
+	assert.ElementsMatch(t, []int{5, 7, 6}, values2)
+	size, _ := s.Size()
+	assert.Equal(t, 4, size)
+	var nilSet *Set[string]
+	_, err = Project(nilSet, func(word string) int { return len(word) })
+	assert.ErrorIs(t, err, ErrNilSet)
+}
+
+// TestSetPartition() verifies that Partition() splits the set into two disjoint
+// sets whose union is the original set.
+func TestSetPartition(t *testing.T) {
+	s := NewSet(1, 2, 3, 4, 5, 6, 7)
+	even, odd, err := s.Partition(func(value int) bool { return value%2 == 0 })
+	assert.NoError(t, err)
+	evenValues, _ := even.Values()
+	oddValues, _ := odd.Values()
+	assert.ElementsMatch(t, []int{2, 4, 6}, evenValues)
+	assert.ElementsMatch(t, []int{1, 3, 5, 7}, oddValues)
+	intersection, _ := even.Intersection(odd)
+	empty, _ := intersection.IsEmpty()
+	assert.True(t, empty)
+	union, _ := even.Union(odd)
+	equal, _ := union.Equal(s)
+	assert.True(t, equal)
+	all, none, err := s.Partition(func(value int) bool { return true })
+	assert.NoError(t, err)
+	equal, _ = all.Equal(s)
+	assert.True(t, equal)
+	empty, _ = none.IsEmpty()
+	assert.True(t, empty)
+	var nilSet *Set[int]
+	_, _, err = nilSet.Partition(func(value int) bool { return true })
+	assert.ErrorIs(t, err, ErrNilSet)
+}
+
+// TestSetHash() verifies that equal sets hash equally regardless of insertion
+// order and that differing sets produce different hashes.
+func TestSetHash(t *testing.T) {
+	a := NewSet("alpha", "beta", "gamma")
+	b := NewSet("gamma", "alpha")
+	b.Add("beta")
+	hashA, err := a.Hash()
+	assert.NoError(t, err)
+	hashB, err := b.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, hashA, hashB)
+	hashes := map[uint64]bool{hashA: true}
+	for _, other := range []*Set[string]{NewSet("alpha", "beta"), NewSet("alpha", "beta", "delta"), NewSet("gamma")} {
+		hash, _ := other.Hash()
+		assert.False(t, hashes[hash])
+		hashes[hash] = true
+	}
+	empty, err := NewSet[string]().Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), empty)
+	var nilSet *Set[string]
+	_, err = nilSet.Hash()
+	assert.ErrorIs(t, err, ErrNilSet)
+}
+
+// TestSetHashNormalizesZero() verifies that sets holding positive and negative
+// floating-point zero, which are the same element, hash equally.
+func TestSetHashNormalizesZero(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+	positive := NewSet(0.0)
+	negative := NewSet(negativeZero)
+	equal, _ := positive.Equal(negative)
+	assert.True(t, equal)
+	hashPositive, _ := positive.Hash()
+	hashNegative, _ := negative.Hash()
+	assert.Equal(t, hashPositive, hashNegative)
+	hash32Positive, _ := NewSet(float32(0)).Hash()
+	hash32Negative, _ := NewSet(float32(negativeZero)).Hash()
+	assert.Equal(t, hash32Positive, hash32Negative)
+	anyPositive, _ := NewSet[any](0.0).Hash()
+	anyNegative, _ := NewSet[any](negativeZero).Hash()
+	assert.Equal(t, anyPositive, anyNegative)
+}
+
+// TestSetHashDistinguishesTypes() verifies that equal bit patterns of different
+// types in a set of interfaces hash differently, and that other element types
+// still hash consistently.
+func TestSetHashDistinguishesTypes(t *testing.T) {
+	hashInt, _ := NewSet[any](1).Hash()
+	hashInt64, _ := NewSet[any](int64(1)).Hash()
+	assert.NotEqual(t, hashInt, hashInt64)
+	type point struct{ x, y int }
+	a, _ := NewSet(point{1, 2}, point{3, 4}).Hash()
+	b, _ := NewSet(point{3, 4}, point{1, 2}).Hash()
+	assert.Equal(t, a, b)
+}
+
+// TestSetHashDoesNotAllocate() verifies that hashing a set of a predeclared type
+// does not allocate.
+func TestSetHashDoesNotAllocate(t *testing.T) {
+	ints := NewSet(1, 2, 3, 4, 5)
+	strs := NewSet("alpha", "beta", "gamma")
+	floats := NewSet(0.5, -1.25, 3.0)
+	assert.Zero(t, testing.AllocsPerRun(10, func() {
+		ints.Hash()
+		strs.Hash()
+		floats.Hash()
+	}))
+}
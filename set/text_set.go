@@ -0,0 +1,117 @@
+// Package set provides a generic set data structure implemented using Go generics.
+// It allows storing and manipulating unique elements of any comparable type (T).
+//
+// This package is useful for operations requiring collections of unique items,
+// such as membership tests, unions, intersections, and set differences.
+//
+// Included features:
+//   - Create a new set with initial elements.
+//   - Add elements to the set (ensuring uniqueness).
+//   - Remove elements from the set.
+//   - Check if an element exists in the set.
+//   - Get the number of elements in the set.
+//   - Retrieve all elements as a slice.
+//   - Clear all elements from the set.
+//   - Check if the set is empty.
+//   - Perform set operations: union, intersection, difference, symmetric difference.
+//   - Compare sets for equality, subset, and superset relationships.
+//   - Get a string representation of the set contents.
+//   - Generate the power set (all subsets) of a set.
+//   - Compute the Cartesian product of two sets.
+//   - Join the elements into a delimited string.
+//   - Pre-size a set when the number of elements is known in advance.
+//   - Reduce the set to a single accumulated value.
+//   - Reset a set while reusing its allocated memory.
+//   - Subtract several sets at once.
+//   - Find the minimum and maximum elements with a comparator.
+//   - Compute the symmetric difference of several sets.
+//   - Check whether all or any of several elements are present.
+//   - Retrieve all elements as a slice sorted by a comparator.
+//   - Visit every element with a callback that can abort on error.
+//   - Add elements and report how many were new.
+//   - Freeze a set into an immutable FrozenSet.
+//   - Project the elements into a set of derived values.
+//   - Partition the elements into two sets by a predicate.
+//   - Wrap sets of strings in a TextSet that encodes as sorted comma-separated text.
+//   - Compute an order-independent hash of the elements.
+//
+// Most methods return an error if the set receiver is nil.
+package set
+
+import (
+	"sort"
+	"strings"
+)
+
+// textSeparator is the separator placed between elements in the text encoding of
+// a TextSet.
+const textSeparator = ","
+
+// TextSet[T ~string] represents a set of string-based elements that can be
+// encoded as text. It embeds a Set, so every Set operation is available on it,
+// and adds MarshalText() and UnmarshalText() so it embeds cleanly in text-based
+// formats such as JSON.
+type TextSet[T ~string] struct {
+	*Set[T]
+}
+
+// NewTextSet[T ~string]() creates and returns a new text-encodable set containing
+// the specified elements.
+//
+// Parameters:
+//   - elements: A variadic list of string-based elements to add to the set.
+//
+// Returns:
+//   - A pointer to a new TextSet containing the provided elements.
+func NewTextSet[T ~string](elements ...T) *TextSet[T] {
+	return &TextSet[T]{Set: NewSet(elements...)}
+}
+
+// MarshalText() encodes the set as its elements sorted and joined by commas. No
+// element may contain a comma. Since an empty set encodes as an empty string, a
+// set holding only the empty string cannot be told apart from an empty one.
+//
+// Returns:
+//   - The text encoding of the set.
+//   - An error if the set is nil or an element contains a comma.
+func (s *TextSet[T]) MarshalText() ([]byte, error) {
+	if s == nil || s.Set == nil {
+		return nil, ErrNilSet
+	}
+	parts := make([]string, 0, len(s.elements))
+	for k := range s.elements {
+		part := string(k)
+		if strings.Contains(part, textSeparator) {
+			return nil, ErrSeparatorInElement
+		}
+		parts = append(parts, part)
+	}
+	sort.Strings(parts)
+	return []byte(strings.Join(parts, textSeparator)), nil
+}
+
+// UnmarshalText() decodes a comma-separated list of elements produced by
+// MarshalText(), replacing the current contents of the set. A TextSet with no
+// underlying Set, such as its zero value, gets a new one.
+//
+// Parameters:
+//   - text: The text encoding of the set.
+//
+// Returns:
+//   - An error if the set is nil.
+func (s *TextSet[T]) UnmarshalText(text []byte) error {
+	if s == nil {
+		return ErrNilSet
+	}
+	if s.Set == nil {
+		s.Set = NewSet[T]()
+	}
+	s.elements = make(map[T]struct{})
+	if len(text) == 0 {
+		return nil
+	}
+	for _, part := range strings.Split(string(text), textSeparator) {
+		s.elements[T(part)] = struct{}{}
+	}
+	return nil
+}
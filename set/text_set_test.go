@@ -0,0 +1,109 @@
+// Package set provides a generic set data structure implemented using Go generics.
+// It allows storing and manipulating unique elements of any comparable type (T).
+//
+// This package is useful for operations requiring collections of unique items,
+// such as membership tests, unions, intersections, and set differences.
+//
+// Included features:
+//   - Create a new set with initial elements.
+//   - Add elements to the set (ensuring uniqueness).
+//   - Remove elements from the set.
+//   - Check if an element exists in the set.
+//   - Get the number of elements in the set.
+//   - Retrieve all elements as a slice.
+//   - Clear all elements from the set.
+//   - Check if the set is empty.
+//   - Perform set operations: union, intersection, difference, symmetric difference.
+//   - Compare sets for equality, subset, and superset relationships.
+//   - Get a string representation of the set contents.
+//   - Generate the power set (all subsets) of a set.
+//   - Compute the Cartesian product of two sets.
+//   - Join the elements into a delimited string.
+//   - Pre-size a set when the number of elements is known in advance.
+//   - Reduce the set to a single accumulated value.
+//   - Reset a set while reusing its allocated memory.
+//   - Subtract several sets at once.
+//   - Find the minimum and maximum elements with a comparator.
+//   - Compute the symmetric difference of several sets.
+//   - Check whether all or any of several elements are present.
+//   - Retrieve all elements as a slice sorted by a comparator.
+//   - Visit every element with a callback that can abort on error.
+//   - Add elements and report how many were new.
+//   - Freeze a set into an immutable FrozenSet.
+//   - Project the elements into a set of derived values.
+//   - Partition the elements into two sets by a predicate.
+//   - Wrap sets of strings in a TextSet that encodes as sorted comma-separated text.
+//   - Compute an order-independent hash of the elements.
+//
+// Most methods return an error if the set receiver is nil.
+package set
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTextSetMarshalText() verifies that MarshalText() encodes the elements sorted
+// and comma-separated, and that UnmarshalText() restores an equal set.
+func TestTextSetMarshalText(t *testing.T) {
+	s := NewTextSet("pear", "apple", "fig")
+	text, err := s.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "apple,fig,pear", string(text))
+	decoded := NewTextSet("stale")
+	assert.NoError(t, decoded.UnmarshalText(text))
+	equal, _ := decoded.Equal(s.Set)
+	assert.True(t, equal)
+	var zero TextSet[string]
+	assert.NoError(t, zero.UnmarshalText([]byte("b,a")))
+	values, _ := zero.Values()
+	assert.ElementsMatch(t, []string{"a", "b"}, values)
+	text, err = NewTextSet[string]().MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+	assert.NoError(t, decoded.UnmarshalText(text))
+	empty, _ := decoded.IsEmpty()
+	assert.True(t, empty)
+}
+
+// TestTextSetJSON() verifies that a text set of a string-based type embedded in a
+// struct round-trips through JSON as a compact string.
+func TestTextSetJSON(t *testing.T) {
+	type tag string
+	type document struct {
+		Tags *TextSet[tag] `json:"tags"`
+	}
+	encoded, err := json.Marshal(document{Tags: NewTextSet[tag]("go", "data", "set")})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"tags":"data,go,set"}`, string(encoded))
+	var decoded document
+	assert.NoError(t, json.Unmarshal(encoded, &decoded))
+	values, _ := decoded.Tags.Values()
+	assert.ElementsMatch(t, []tag{"go", "data", "set"}, values)
+}
+
+// TestTextSetErrors() verifies that the text encoding rejects nil sets and
+// elements containing the separator.
+func TestTextSetErrors(t *testing.T) {
+	var nilSet *TextSet[string]
+	_, err := nilSet.MarshalText()
+	assert.ErrorIs(t, err, ErrNilSet)
+	assert.ErrorIs(t, nilSet.UnmarshalText([]byte("a")), ErrNilSet)
+	_, err = (&TextSet[string]{}).MarshalText()
+	assert.ErrorIs(t, err, ErrNilSet)
+	_, err = NewTextSet("a,b").MarshalText()
+	assert.ErrorIs(t, err, ErrSeparatorInElement)
+}
+
+// TestSetJSONIsUnaffected() verifies that a plain Set, which has no text
+// encoding, still marshals to JSON without an error for any element type.
+func TestSetJSONIsUnaffected(t *testing.T) {
+	type document struct {
+		IDs *Set[int] `json:"ids"`
+	}
+	encoded, err := json.Marshal(document{IDs: NewSet(1, 2)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"ids":{}}`, string(encoded))
+}
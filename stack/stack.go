@@ -14,6 +14,14 @@
 //   - Get the number of elements in the stack.
 //   - Clear all elements from the stack.
 //   - Get a string representation of the stack contents.
+//   - Reverse the order of the elements in place.
+//   - Iterate over the elements from top to bottom.
+//   - Compare two stacks for identical contents and order.
+//   - Drain all the elements into a slice in pop order, emptying the stack.
+//   - Pre-allocate a stack when the number of elements is known in advance.
+//   - Push several elements at once.
+//   - Transform every element into a new stack.
+//   - Pop a batch of elements at once.
 //
 // Attempting to pop or peek from an empty stack will return an error.
 package stack
@@ -23,6 +31,10 @@ import (
 	"fmt"
 )
 
+// ErrEmptyStack is returned when an element is popped or inspected from an empty
+// stack.
+var ErrEmptyStack = errors.New("stack empty")
+
 // Stack[T any] represents a generic stack data structure that can store any type
 // of data (T). The stack is implemented internally as a slice of type T.
 type Stack[T any] struct {
@@ -38,6 +50,18 @@ func NewStack[T any]() *Stack[T] {
 	return &Stack[T]{data: make([]T, 0)}
 }
 
+// NewStackWithCapacity[T any]() creates and returns a new empty stack whose
+// internal slice is pre-allocated to hold the given number of elements.
+//
+// Parameters:
+//   - capacity: The number of elements the stack is expected to hold.
+//
+// Returns:
+//   - A pointer to a new empty stack.
+func NewStackWithCapacity[T any](capacity int) *Stack[T] {
+	return &Stack[T]{data: make([]T, 0, capacity)}
+}
+
 // Push() adds an element to the top of the stack. The element is appended to the
 // end of the internal slice.
 //
@@ -47,6 +71,15 @@ func (s *Stack[T]) Push(data T) {
 	s.data = append(s.data, data)
 }
 
+// PushAll() adds several elements to the top of the stack in argument order, so
+// the last argument ends up on top. A slice can be pushed with PushAll(items...).
+//
+// Parameters:
+//   - items: The elements to be added to the stack.
+func (s *Stack[T]) PushAll(items ...T) {
+	s.data = append(s.data, items...)
+}
+
 // Pop() removes and returns the element at the top of the stack. If the stack is
 // empty, it returns an error and the zero value for the type T.
 //
@@ -56,7 +89,7 @@ func (s *Stack[T]) Push(data T) {
 func (s *Stack[T]) Pop() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("stack empty")
+		return zero, ErrEmptyStack
 	}
 	index := len(s.data) - 1
 	value := s.data[index]
@@ -64,6 +97,25 @@ func (s *Stack[T]) Pop() (T, error) {
 	return value, nil
 }
 
+// PopN() removes and returns up to n elements from the top of the stack, in pop
+// order. If the stack holds fewer than n elements, all of them are returned.
+//
+// Parameters:
+//   - n: The maximum number of elements to remove.
+//
+// Returns:
+//   - A slice with the removed elements from top to bottom, empty but non-nil if n
+//     is not positive or the stack is empty.
+func (s *Stack[T]) PopN(n int) []T {
+	n = max(0, min(n, len(s.data)))
+	popped := make([]T, 0, n)
+	for i := len(s.data) - 1; i >= len(s.data)-n; i-- {
+		popped = append(popped, s.data[i])
+	}
+	s.data = s.data[:len(s.data)-n]
+	return popped
+}
+
 // Top() returns the element at the top of the stack without removing it. If the
 // stack is empty, it returns an error and the zero value for the type T.
 //
@@ -73,7 +125,7 @@ func (s *Stack[T]) Pop() (T, error) {
 func (s *Stack[T]) Top() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("stack empty")
+		return zero, ErrEmptyStack
 	}
 	return s.data[len(s.data)-1], nil
 }
@@ -109,3 +161,90 @@ func (s *Stack[T]) Clear() {
 func (s *Stack[T]) String() string {
 	return fmt.Sprintf("Stack: %v", s.data)
 }
+
+// Reverse() reverses the order of the elements in the stack, so the current
+// bottom element becomes the top.
+func (s *Stack[T]) Reverse() {
+	for i, j := 0, len(s.data)-1; i < j; i, j = i+1, j-1 {
+		s.data[i], s.data[j] = s.data[j], s.data[i]
+	}
+}
+
+// ForEach() applies the given function to each element of the stack, from top to
+// bottom, without modifying the stack.
+//
+// Parameters:
+//   - f: A function that takes a value of type T. It is applied to each element
+//     starting with the most recently pushed one.
+func (s *Stack[T]) ForEach(f func(T)) {
+	for i := len(s.data) - 1; i >= 0; i-- {
+		f(s.data[i])
+	}
+}
+
+// Equal() checks whether the stack holds the same elements as another stack, in
+// the same order.
+//
+// Parameters:
+//   - other: The stack to compare with.
+//   - equal: A function that reports whether two elements are equal.
+//
+// Returns:
+//   - true if both stacks have the same size and equal elements in the same order.
+//   - false otherwise.
+func (s *Stack[T]) Equal(other *Stack[T], equal func(a, b T) bool) bool {
+	if s.Size() != other.Size() {
+		return false
+	}
+	for i := range s.data {
+		if !equal(s.data[i], other.data[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualComparable[T comparable]() checks whether two stacks hold the same elements
+// in the same order, comparing elements with ==.
+//
+// Parameters:
+//   - a: The first stack to compare.
+//   - b: The second stack to compare.
+//
+// Returns:
+//   - true if both stacks have the same size and equal elements in the same order.
+//   - false otherwise.
+func EqualComparable[T comparable](a, b *Stack[T]) bool {
+	return a.Equal(b, func(x, y T) bool { return x == y })
+}
+
+// Drain() removes every element from the stack and returns them in pop order, from
+// top to bottom, leaving the stack empty.
+//
+// Returns:
+//   - A slice with all the elements of the stack, empty but non-nil if the stack
+//     was empty.
+func (s *Stack[T]) Drain() []T {
+	drained := make([]T, 0, len(s.data))
+	s.ForEach(func(value T) { drained = append(drained, value) })
+	s.Clear()
+	return drained
+}
+
+// Map[T, U any]() returns a new stack whose elements are the result of applying the
+// given function to each element of the stack, keeping the top-to-bottom order.
+// The source stack is left unchanged.
+//
+// Parameters:
+//   - s: The stack whose elements are to be transformed.
+//   - f: A function that transforms an element of type T into one of type U.
+//
+// Returns:
+//   - A pointer to a new stack with the transformed elements.
+func Map[T, U any](s *Stack[T], f func(T) U) *Stack[U] {
+	result := NewStackWithCapacity[U](len(s.data))
+	for _, value := range s.data {
+		result.Push(f(value))
+	}
+	return result
+}
@@ -175,3 +175,176 @@ func TestStackWithStruct(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, Point{1, 2}, top)
 }
+
+// TestStackReverse() verifies that Reverse() flips the order of the elements so
+// they are popped in insertion order.
+func TestStackReverse(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	s.Reverse()
+	for _, expected := range []int{1, 2, 3} {
+		val, err := s.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, val)
+	}
+	assert.True(t, s.IsEmpty())
+}
+
+// TestStackReverseEmptyAndSingle() ensures that Reverse() is a no-op on empty and
+// single-element stacks.
+func TestStackReverseEmptyAndSingle(t *testing.T) {
+	s := NewStack[int]()
+	s.Reverse()
+	assert.True(t, s.IsEmpty())
+	s.Push(7)
+	s.Reverse()
+	top, err := s.Top()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, top)
+}
+
+// TestStackForEach() verifies that ForEach() visits the elements from top to
+// bottom and leaves the stack unchanged.
+func TestStackForEach(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	var visited []int
+	s.ForEach(func(value int) { visited = append(visited, value) })
+	assert.Equal(t, []int{3, 2, 1}, visited)
+	assert.Equal(t, 3, s.Size())
+}
+
+// TestStackEqual() verifies that Equal() and EqualComparable() compare stacks by
+// size, contents and order.
+func TestStackEqual(t *testing.T) {
+	a := NewStack[int]()
+	b := NewStack[int]()
+	reordered := NewStack[int]()
+	shorter := NewStack[int]()
+	for _, v := range []int{1, 2, 3} {
+		a.Push(v)
+		b.Push(v)
+	}
+	for _, v := range []int{3, 2, 1} {
+		reordered.Push(v)
+	}
+	shorter.Push(1)
+	shorter.Push(2)
+	equal := func(x, y int) bool { return x == y }
+	assert.True(t, a.Equal(b, equal))
+	assert.False(t, a.Equal(reordered, equal))
+	assert.False(t, a.Equal(shorter, equal))
+	assert.True(t, EqualComparable(a, b))
+	assert.False(t, EqualComparable(a, reordered))
+	assert.False(t, EqualComparable(a, shorter))
+}
+
+// TestStackDrain() verifies that Drain() returns the elements in pop order and
+// leaves the stack empty.
+func TestStackDrain(t *testing.T) {
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	assert.Equal(t, []int{3, 2, 1}, s.Drain())
+	assert.True(t, s.IsEmpty())
+	drained := s.Drain()
+	assert.NotNil(t, drained)
+	assert.Empty(t, drained)
+}
+
+// TestStackNewStackWithCapacity() verifies that a pre-allocated stack is empty and
+// behaves like a regular stack.
+func TestStackNewStackWithCapacity(t *testing.T) {
+	s := NewStackWithCapacity[int](10)
+	assert.True(t, s.IsEmpty())
+	s.Push(1)
+	s.Push(2)
+	v, err := s.Pop()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+// BenchmarkStackPushDefault() measures pushing a known number of elements onto a
+// stack created without a capacity hint.
+func BenchmarkStackPushDefault(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		s := NewStack[int]()
+		for i := range 10000 {
+			s.Push(i)
+		}
+	}
+}
+
+// BenchmarkStackPushWithCapacity() measures pushing a known number of elements
+// onto a stack created with NewStackWithCapacity().
+func BenchmarkStackPushWithCapacity(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		s := NewStackWithCapacity[int](10000)
+		for i := range 10000 {
+			s.Push(i)
+		}
+	}
+}
+
+// TestStackPushAll() verifies that PushAll() pushes elements in argument order so
+// the last one ends up on top, both with variadic arguments and a slice.
+func TestStackPushAll(t *testing.T) {
+	s := NewStack[int]()
+	s.PushAll(1, 2, 3)
+	top, err := s.Top()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, top)
+	s.PushAll([]int{4, 5}...)
+	assert.Equal(t, 5, s.Size())
+	assert.Equal(t, "Stack: [1 2 3 4 5]", s.String())
+	s.PushAll()
+	assert.Equal(t, 5, s.Size())
+}
+
+// TestStackErrEmptyStack() verifies that Pop() and Top() on an empty stack return
+// an error matching ErrEmptyStack.
+func TestStackErrEmptyStack(t *testing.T) {
+	s := NewStack[int]()
+	_, err := s.Pop()
+	assert.ErrorIs(t, err, ErrEmptyStack)
+	_, err = s.Top()
+	assert.ErrorIs(t, err, ErrEmptyStack)
+}
+
+// TestStackMap() verifies that Map() transforms every element into a new stack
+// with the same top-to-bottom order, leaving the source stack untouched.
+func TestStackMap(t *testing.T) {
+	s := NewStack[int]()
+	s.PushAll(1, 2, 3)
+	mapped := Map(s, func(value int) float64 { return float64(value) / 2 })
+	top, err := mapped.Top()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, top)
+	assert.Equal(t, []float64{1.5, 1, 0.5}, mapped.Drain())
+	assert.Equal(t, "Stack: [1 2 3]", s.String())
+	assert.True(t, Map(NewStack[int](), func(value int) int { return value }).IsEmpty())
+}
+
+// TestStackPopN() verifies that PopN() removes up to n elements from the top in
+// pop order and returns an empty slice for a non-positive n.
+func TestStackPopN(t *testing.T) {
+	s := NewStack[int]()
+	s.PushAll(1, 2, 3, 4, 5)
+	assert.Equal(t, []int{5, 4}, s.PopN(2))
+	assert.Equal(t, "Stack: [1 2 3]", s.String())
+	empty := s.PopN(0)
+	assert.NotNil(t, empty)
+	assert.Empty(t, empty)
+	assert.Empty(t, s.PopN(-3))
+	assert.Equal(t, 3, s.Size())
+	assert.Equal(t, []int{3, 2, 1}, s.PopN(10))
+	assert.True(t, s.IsEmpty())
+	assert.Empty(t, s.PopN(1))
+}
@@ -0,0 +1,49 @@
+// Package tuple provides small generic tuple types implemented using Go generics.
+// They are shared by the collection packages wherever a method needs to return or
+// accept grouped values, such as the entries of a dictionary or the pairs of a
+// Cartesian product.
+//
+// Included features:
+//   - Create a pair of values of any two types.
+//   - Destructure a pair back into its values.
+//   - Get a string representation of a pair.
+package tuple
+
+import "fmt"
+
+// Pair[A, B any] represents an ordered pair of values, where the first value is of
+// type A and the second of type B.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// MakePair[A, B any]() creates and returns a new pair with the given values.
+//
+// Parameters:
+//   - first: The first value of the pair.
+//   - second: The second value of the pair.
+//
+// Returns:
+//   - A Pair containing both values.
+func MakePair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Unpack() returns both values of the pair, so it can be destructured in a single
+// assignment.
+//
+// Returns:
+//   - The first value of the pair.
+//   - The second value of the pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// String() returns a string representation of the pair.
+//
+// Returns:
+//   - A string with both values enclosed in parentheses.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
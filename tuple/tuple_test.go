@@ -0,0 +1,36 @@
+// Package tuple provides small generic tuple types implemented using Go generics.
+// They are shared by the collection packages wherever a method needs to return or
+// accept grouped values, such as the entries of a dictionary or the pairs of a
+// Cartesian product.
+//
+// Included features:
+//   - Create a pair of values of any two types.
+//   - Destructure a pair back into its values.
+//   - Get a string representation of a pair.
+package tuple
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMakePair() verifies that MakePair() stores both values in order.
+func TestMakePair(t *testing.T) {
+	pair := MakePair("age", 42)
+	assert.Equal(t, "age", pair.First)
+	assert.Equal(t, 42, pair.Second)
+	assert.Equal(t, Pair[string, int]{First: "age", Second: 42}, pair)
+}
+
+// TestPairUnpack() verifies that Unpack() destructures a pair into its values.
+func TestPairUnpack(t *testing.T) {
+	first, second := MakePair(1.5, true).Unpack()
+	assert.Equal(t, 1.5, first)
+	assert.True(t, second)
+}
+
+// TestPairString() verifies the string representation of a pair.
+func TestPairString(t *testing.T) {
+	assert.Equal(t, "(a, 1)", MakePair("a", 1).String())
+}